@@ -3,6 +3,7 @@ package meilisearch
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"regexp"
 	"strconv"
@@ -23,8 +24,31 @@ type ClientConfig struct {
 	// APIKey is optional
 	APIKey string
 
-	// Timeout is optional
+	// Timeout is optional, it bounds each attempt of a request. Without it, a request sent
+	// with the default fasthttp.Client is only bounded by its context, see NewFastHTTPTransport.
 	Timeout time.Duration
+
+	// CustomHeaders are optional, they are sent with each request and replace the headers set by the Client
+	CustomHeaders map[string]string
+
+	// ClientAgents are optional, they identify the application using this library in the
+	// X-Meilisearch-Client header, e.g. "My App (v1.2.3)"
+	ClientAgents []string
+
+	// RetryPolicy is optional, failed requests are not retried when nil
+	RetryPolicy *RetryPolicy
+
+	// Middlewares are optional, they wrap the sending of each request in the given order,
+	// the first one being the outermost
+	Middlewares []Middleware
+
+	// Logger is optional, each request is logged at the debug level with its method, endpoint,
+	// latency and status code. The Authorization header is redacted.
+	Logger *slog.Logger
+
+	// LogBodyLimit is the maximum number of bytes of the request and response bodies written
+	// by the Logger, the bodies are not logged when it is 0 and fully logged when it is negative.
+	LogBodyLimit int
 }
 
 type WaitParams struct {
@@ -36,38 +60,81 @@ type WaitParams struct {
 type ClientInterface interface {
 	Index(uid string) *Index
 	GetIndex(indexID string) (resp *Index, err error)
+	GetIndexWithContext(ctx context.Context, indexID string) (resp *Index, err error)
 	GetRawIndex(uid string) (resp map[string]interface{}, err error)
+	GetRawIndexWithContext(ctx context.Context, uid string) (resp map[string]interface{}, err error)
 	GetIndexes(param *IndexesQuery) (resp *IndexesResults, err error)
+	GetIndexesWithContext(ctx context.Context, param *IndexesQuery) (resp *IndexesResults, err error)
 	GetRawIndexes(param *IndexesQuery) (resp map[string]interface{}, err error)
+	GetRawIndexesWithContext(ctx context.Context, param *IndexesQuery) (resp map[string]interface{}, err error)
 	CreateIndex(config *IndexConfig) (resp *TaskInfo, err error)
+	CreateIndexWithContext(ctx context.Context, config *IndexConfig) (resp *TaskInfo, err error)
 	DeleteIndex(uid string) (resp *TaskInfo, err error)
+	DeleteIndexWithContext(ctx context.Context, uid string) (resp *TaskInfo, err error)
 	CreateKey(request *Key) (resp *Key, err error)
+	CreateKeyWithContext(ctx context.Context, request *Key) (resp *Key, err error)
 	GetKey(identifier string) (resp *Key, err error)
+	GetKeyWithContext(ctx context.Context, identifier string) (resp *Key, err error)
 	GetKeys(param *KeysQuery) (resp *KeysResults, err error)
+	GetKeysWithContext(ctx context.Context, param *KeysQuery) (resp *KeysResults, err error)
 	UpdateKey(keyOrUID string, request *Key) (resp *Key, err error)
+	UpdateKeyWithContext(ctx context.Context, keyOrUID string, request *Key) (resp *Key, err error)
 	DeleteKey(keyOrUID string) (resp bool, err error)
+	DeleteKeyWithContext(ctx context.Context, keyOrUID string) (resp bool, err error)
 	GetStats() (resp *Stats, err error)
+	GetStatsWithContext(ctx context.Context) (resp *Stats, err error)
 	CreateDump() (resp *TaskInfo, err error)
+	CreateDumpWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	Version() (*Version, error)
+	VersionWithContext(ctx context.Context) (*Version, error)
 	GetVersion() (resp *Version, err error)
+	GetVersionWithContext(ctx context.Context) (resp *Version, err error)
 	Health() (*Health, error)
+	HealthWithContext(ctx context.Context) (*Health, error)
 	IsHealthy() bool
+	IsHealthyWithContext(ctx context.Context) bool
 	GetTask(taskUID int64) (resp *Task, err error)
+	GetTaskWithContext(ctx context.Context, taskUID int64) (resp *Task, err error)
 	GetTasks(param *TasksQuery) (resp *TaskResult, err error)
+	GetTasksWithContext(ctx context.Context, param *TasksQuery) (resp *TaskResult, err error)
 	CancelTasks(param *CancelTasksQuery) (resp *TaskInfo, err error)
+	CancelTasksWithContext(ctx context.Context, param *CancelTasksQuery) (resp *TaskInfo, err error)
 	DeleteTasks(param *DeleteTasksQuery) (resp *TaskInfo, err error)
+	DeleteTasksWithContext(ctx context.Context, param *DeleteTasksQuery) (resp *TaskInfo, err error)
 	SwapIndexes(param []SwapIndexesParams) (resp *TaskInfo, err error)
+	SwapIndexesWithContext(ctx context.Context, param []SwapIndexesParams) (resp *TaskInfo, err error)
+	MultiSearch(queries *MultiSearchRequest) (*MultiSearchResponse, error)
+	MultiSearchWithContext(ctx context.Context, queries *MultiSearchRequest) (*MultiSearchResponse, error)
+	FederatedMultiSearch(queries *MultiSearchRequest) (*SearchResponse, error)
+	FederatedMultiSearchWithContext(ctx context.Context, queries *MultiSearchRequest) (*SearchResponse, error)
 	WaitForTask(taskUID int64, options ...WaitParams) (*Task, error)
+	WaitForTaskWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error)
 	GenerateTenantToken(APIKeyUID string, searchRules map[string]interface{}, options *TenantTokenOptions) (resp string, err error)
 }
 
 var _ ClientInterface = &Client{}
 
-// NewFastHTTPCustomClient creates Meilisearch with custom fasthttp.Client
+// NewFastHTTPCustomClient creates Meilisearch with custom fasthttp.Client. The requests whose
+// context is canceled are abandoned rather than aborted, see NewFastHTTPTransport.
 func NewFastHTTPCustomClient(config ClientConfig, client *fasthttp.Client) *Client {
+	return NewClientWithTransport(config, NewFastHTTPTransport(client))
+}
+
+// NewNetHTTPCustomClient creates Meilisearch with custom net/http Client
+func NewNetHTTPCustomClient(config ClientConfig, client *http.Client) *Client {
+	return NewClientWithTransport(config, NewNetHTTPTransport(client))
+}
+
+// NewClientWithTransport creates Meilisearch sending its requests through a custom Transport
+func NewClientWithTransport(config ClientConfig, transport Transport) *Client {
 	c := &Client{
-		config:     config,
-		httpClient: client,
+		config:      config,
+		transport:   transport,
+		middlewares: config.Middlewares,
+	}
+	if config.Logger != nil {
+		// The logs are written by the innermost middleware, so they show the request as sent
+		c.middlewares = append(append([]Middleware(nil), config.Middlewares...), newLoggingMiddleware(config.Logger, config.LogBodyLimit))
 	}
 	return c
 }
@@ -79,14 +146,14 @@ func NewClient(config ClientConfig) *Client {
 		// Reuse the most recently-used idle connection.
 		ConnPoolStrategy: fasthttp.LIFO,
 	}
-	c := &Client{
-		config:     config,
-		httpClient: client,
-	}
-	return c
+	return NewFastHTTPCustomClient(config, client)
 }
 
 func (c *Client) Version() (resp *Version, err error) {
+	return c.VersionWithContext(context.Background())
+}
+
+func (c *Client) VersionWithContext(ctx context.Context) (resp *Version, err error) {
 	resp = &Version{}
 	req := internalRequest{
 		endpoint:            "/version",
@@ -96,17 +163,25 @@ func (c *Client) Version() (resp *Version, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "Version",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) GetVersion() (resp *Version, err error) {
-	return c.Version()
+	return c.GetVersionWithContext(context.Background())
+}
+
+func (c *Client) GetVersionWithContext(ctx context.Context) (resp *Version, err error) {
+	return c.VersionWithContext(ctx)
 }
 
 func (c *Client) GetStats() (resp *Stats, err error) {
+	return c.GetStatsWithContext(context.Background())
+}
+
+func (c *Client) GetStatsWithContext(ctx context.Context) (resp *Stats, err error) {
 	resp = &Stats{}
 	req := internalRequest{
 		endpoint:            "/stats",
@@ -116,13 +191,17 @@ func (c *Client) GetStats() (resp *Stats, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetStats",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) CreateKey(request *Key) (resp *Key, err error) {
+	return c.CreateKeyWithContext(context.Background(), request)
+}
+
+func (c *Client) CreateKeyWithContext(ctx context.Context, request *Key) (resp *Key, err error) {
 	parsedRequest := convertKeyToParsedKey(*request)
 	resp = &Key{}
 	req := internalRequest{
@@ -134,13 +213,17 @@ func (c *Client) CreateKey(request *Key) (resp *Key, err error) {
 		acceptedStatusCodes: []int{http.StatusCreated},
 		functionName:        "CreateKey",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) GetKey(identifier string) (resp *Key, err error) {
+	return c.GetKeyWithContext(context.Background(), identifier)
+}
+
+func (c *Client) GetKeyWithContext(ctx context.Context, identifier string) (resp *Key, err error) {
 	resp = &Key{}
 	req := internalRequest{
 		endpoint:            "/keys/" + identifier,
@@ -150,13 +233,17 @@ func (c *Client) GetKey(identifier string) (resp *Key, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetKey",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) GetKeys(param *KeysQuery) (resp *KeysResults, err error) {
+	return c.GetKeysWithContext(context.Background(), param)
+}
+
+func (c *Client) GetKeysWithContext(ctx context.Context, param *KeysQuery) (resp *KeysResults, err error) {
 	resp = &KeysResults{}
 	req := internalRequest{
 		endpoint:            "/keys",
@@ -173,13 +260,17 @@ func (c *Client) GetKeys(param *KeysQuery) (resp *KeysResults, err error) {
 	if param != nil && param.Offset != 0 {
 		req.withQueryParams["offset"] = strconv.FormatInt(param.Offset, 10)
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) UpdateKey(keyOrUID string, request *Key) (resp *Key, err error) {
+	return c.UpdateKeyWithContext(context.Background(), keyOrUID, request)
+}
+
+func (c *Client) UpdateKeyWithContext(ctx context.Context, keyOrUID string, request *Key) (resp *Key, err error) {
 	parsedRequest := KeyUpdate{Name: request.Name, Description: request.Description}
 	resp = &Key{}
 	req := internalRequest{
@@ -191,13 +282,17 @@ func (c *Client) UpdateKey(keyOrUID string, request *Key) (resp *Key, err error)
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "UpdateKey",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) DeleteKey(keyOrUID string) (resp bool, err error) {
+	return c.DeleteKeyWithContext(context.Background(), keyOrUID)
+}
+
+func (c *Client) DeleteKeyWithContext(ctx context.Context, keyOrUID string) (resp bool, err error) {
 	req := internalRequest{
 		endpoint:            "/keys/" + keyOrUID,
 		method:              http.MethodDelete,
@@ -206,13 +301,17 @@ func (c *Client) DeleteKey(keyOrUID string) (resp bool, err error) {
 		acceptedStatusCodes: []int{http.StatusNoContent},
 		functionName:        "DeleteKey",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
 func (c *Client) Health() (resp *Health, err error) {
+	return c.HealthWithContext(context.Background())
+}
+
+func (c *Client) HealthWithContext(ctx context.Context) (resp *Health, err error) {
 	resp = &Health{}
 	req := internalRequest{
 		endpoint:            "/health",
@@ -222,20 +321,28 @@ func (c *Client) Health() (resp *Health, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "Health",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) IsHealthy() bool {
-	if _, err := c.Health(); err != nil {
+	return c.IsHealthyWithContext(context.Background())
+}
+
+func (c *Client) IsHealthyWithContext(ctx context.Context) bool {
+	if _, err := c.HealthWithContext(ctx); err != nil {
 		return false
 	}
 	return true
 }
 
 func (c *Client) CreateDump() (resp *TaskInfo, err error) {
+	return c.CreateDumpWithContext(context.Background())
+}
+
+func (c *Client) CreateDumpWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/dumps",
@@ -246,13 +353,17 @@ func (c *Client) CreateDump() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "CreateDump",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) GetTask(taskUID int64) (resp *Task, err error) {
+	return c.GetTaskWithContext(context.Background(), taskUID)
+}
+
+func (c *Client) GetTaskWithContext(ctx context.Context, taskUID int64) (resp *Task, err error) {
 	resp = &Task{}
 	req := internalRequest{
 		endpoint:            "/tasks/" + strconv.FormatInt(taskUID, 10),
@@ -262,13 +373,17 @@ func (c *Client) GetTask(taskUID int64) (resp *Task, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetTask",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) GetTasks(param *TasksQuery) (resp *TaskResult, err error) {
+	return c.GetTasksWithContext(context.Background(), param)
+}
+
+func (c *Client) GetTasksWithContext(ctx context.Context, param *TasksQuery) (resp *TaskResult, err error) {
 	resp = &TaskResult{}
 	req := internalRequest{
 		endpoint:            "/tasks",
@@ -282,13 +397,17 @@ func (c *Client) GetTasks(param *TasksQuery) (resp *TaskResult, err error) {
 	if param != nil {
 		encodeTasksQuery(param, &req)
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) CancelTasks(param *CancelTasksQuery) (resp *TaskInfo, err error) {
+	return c.CancelTasksWithContext(context.Background(), param)
+}
+
+func (c *Client) CancelTasksWithContext(ctx context.Context, param *CancelTasksQuery) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/tasks/cancel",
@@ -312,13 +431,17 @@ func (c *Client) CancelTasks(param *CancelTasksQuery) (resp *TaskInfo, err error
 		}
 		encodeTasksQuery(paramToSend, &req)
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) DeleteTasks(param *DeleteTasksQuery) (resp *TaskInfo, err error) {
+	return c.DeleteTasksWithContext(context.Background(), param)
+}
+
+func (c *Client) DeleteTasksWithContext(ctx context.Context, param *DeleteTasksQuery) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/tasks",
@@ -345,13 +468,17 @@ func (c *Client) DeleteTasks(param *DeleteTasksQuery) (resp *TaskInfo, err error
 		}
 		encodeTasksQuery(paramToSend, &req)
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) SwapIndexes(param []SwapIndexesParams) (resp *TaskInfo, err error) {
+	return c.SwapIndexesWithContext(context.Background(), param)
+}
+
+func (c *Client) SwapIndexesWithContext(ctx context.Context, param []SwapIndexesParams) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/swap-indexes",
@@ -362,12 +489,85 @@ func (c *Client) SwapIndexes(param []SwapIndexesParams) (resp *TaskInfo, err err
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "SwapIndexes",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) MultiSearch(queries *MultiSearchRequest) (*MultiSearchResponse, error) {
+	return c.MultiSearchWithContext(context.Background(), queries)
+}
+
+func (c *Client) MultiSearchWithContext(ctx context.Context, queries *MultiSearchRequest) (*MultiSearchResponse, error) {
+	resp := &MultiSearchResponse{}
+
+	req := internalRequest{
+		endpoint:            "/multi-search",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         map[string]interface{}{"queries": multiSearchPostQueries(queries)},
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "MultiSearch",
+		readOnly:            true,
+	}
+
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
+
 	return resp, nil
 }
 
+// FederatedMultiSearch merges the hits of every query in a single list ranked by their weighted ranking score.
+// Each hit has a `_federation` field telling the index and the query it comes from, see SearchResponse.HitsFederation.
+func (c *Client) FederatedMultiSearch(queries *MultiSearchRequest) (*SearchResponse, error) {
+	return c.FederatedMultiSearchWithContext(context.Background(), queries)
+}
+
+func (c *Client) FederatedMultiSearchWithContext(ctx context.Context, queries *MultiSearchRequest) (*SearchResponse, error) {
+	resp := &SearchResponse{}
+
+	federation := queries.Federation
+	if federation == nil {
+		federation = &MultiSearchFederation{}
+	}
+
+	req := internalRequest{
+		endpoint:    "/multi-search",
+		method:      http.MethodPost,
+		contentType: contentTypeJSON,
+		withRequest: map[string]interface{}{
+			"federation": federation,
+			"queries":    multiSearchPostQueries(queries),
+		},
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "FederatedMultiSearch",
+		readOnly:            true,
+	}
+
+	if err := c.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func multiSearchPostQueries(queries *MultiSearchRequest) []map[string]interface{} {
+	searchPostQueries := make([]map[string]interface{}, len(queries.Queries))
+	for i := range queries.Queries {
+		request := &queries.Queries[i]
+		if request.Limit == 0 {
+			request.Limit = DefaultLimit
+		}
+		searchPostQueries[i] = searchPostRequestParams(request.Query, request)
+		searchPostQueries[i]["indexUid"] = request.IndexUID
+	}
+	return searchPostQueries
+}
+
 // WaitForTask waits for a task to be processed
 //
 // The function will check by regular interval provided in parameter interval
@@ -381,22 +581,39 @@ func (c *Client) WaitForTask(taskUID int64, options ...WaitParams) (*Task, error
 		options = []WaitParams{
 			{
 				Context:  ctx,
-				Interval: time.Millisecond * 50,
+				Interval: defaultWaitInterval,
 			},
 		}
 	}
+	return c.WaitForTaskWithContext(options[0].Context, taskUID, options[0].Interval)
+}
+
+// defaultWaitInterval is the interval between two checks of the status of a task
+const defaultWaitInterval = 50 * time.Millisecond
+
+// WaitForTaskWithContext waits for a task to be processed, checking its
+// TaskStatus every interval until the task is done or ctx is canceled.
+// The status is checked every 50ms when interval is not positive.
+func (c *Client) WaitForTaskWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error) {
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
 	for {
-		if err := options[0].Context.Err(); err != nil {
+		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
-		getTask, err := c.GetTask(taskUID)
+		getTask, err := c.GetTaskWithContext(ctx, taskUID)
 		if err != nil {
 			return nil, err
 		}
 		if getTask.Status != TaskStatusEnqueued && getTask.Status != TaskStatusProcessing {
 			return getTask, nil
 		}
-		time.Sleep(options[0].Interval)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
 	}
 }
 
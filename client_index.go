@@ -1,6 +1,7 @@
 package meilisearch
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 )
@@ -10,10 +11,18 @@ func (c *Client) Index(uid string) *Index {
 }
 
 func (c *Client) GetIndex(uid string) (resp *Index, err error) {
-	return newIndex(c, uid).FetchInfo()
+	return c.GetIndexWithContext(context.Background(), uid)
+}
+
+func (c *Client) GetIndexWithContext(ctx context.Context, uid string) (resp *Index, err error) {
+	return newIndex(c, uid).FetchInfoWithContext(ctx)
 }
 
 func (c *Client) GetRawIndex(uid string) (resp map[string]interface{}, err error) {
+	return c.GetRawIndexWithContext(context.Background(), uid)
+}
+
+func (c *Client) GetRawIndexWithContext(ctx context.Context, uid string) (resp map[string]interface{}, err error) {
 	resp = map[string]interface{}{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + uid,
@@ -23,13 +32,17 @@ func (c *Client) GetRawIndex(uid string) (resp map[string]interface{}, err error
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetRawIndex",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) CreateIndex(config *IndexConfig) (resp *TaskInfo, err error) {
+	return c.CreateIndexWithContext(context.Background(), config)
+}
+
+func (c *Client) CreateIndexWithContext(ctx context.Context, config *IndexConfig) (resp *TaskInfo, err error) {
 	request := &CreateIndexRequest{
 		UID:        config.Uid,
 		PrimaryKey: config.PrimaryKey,
@@ -44,13 +57,17 @@ func (c *Client) CreateIndex(config *IndexConfig) (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "CreateIndex",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) GetIndexes(param *IndexesQuery) (resp *IndexesResults, err error) {
+	return c.GetIndexesWithContext(context.Background(), param)
+}
+
+func (c *Client) GetIndexesWithContext(ctx context.Context, param *IndexesQuery) (resp *IndexesResults, err error) {
 	resp = &IndexesResults{}
 	req := internalRequest{
 		endpoint:            "/indexes",
@@ -67,13 +84,17 @@ func (c *Client) GetIndexes(param *IndexesQuery) (resp *IndexesResults, err erro
 	if param != nil && param.Offset != 0 {
 		req.withQueryParams["offset"] = strconv.FormatInt(param.Offset, 10)
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) GetRawIndexes(param *IndexesQuery) (resp map[string]interface{}, err error) {
+	return c.GetRawIndexesWithContext(context.Background(), param)
+}
+
+func (c *Client) GetRawIndexesWithContext(ctx context.Context, param *IndexesQuery) (resp map[string]interface{}, err error) {
 	resp = map[string]interface{}{}
 	req := internalRequest{
 		endpoint:            "/indexes",
@@ -90,13 +111,17 @@ func (c *Client) GetRawIndexes(param *IndexesQuery) (resp map[string]interface{}
 	if param != nil && param.Offset != 0 {
 		req.withQueryParams["offset"] = strconv.FormatInt(param.Offset, 10)
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (c *Client) DeleteIndex(uid string) (resp *TaskInfo, err error) {
+	return c.DeleteIndexWithContext(context.Background(), uid)
+}
+
+func (c *Client) DeleteIndexWithContext(ctx context.Context, uid string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + uid,
@@ -106,7 +131,7 @@ func (c *Client) DeleteIndex(uid string) (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "DeleteIndex",
 	}
-	if err := c.executeRequest(req); err != nil {
+	if err := c.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
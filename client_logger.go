@@ -0,0 +1,65 @@
+package meilisearch
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const redactedHeaderValue = "[REDACTED]"
+
+// newLoggingMiddleware returns a Middleware logging each request at the debug level
+func newLoggingMiddleware(logger *slog.Logger, bodyLimit int) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(ctx context.Context, req *Request) (*TransportResponse, error) {
+			if !logger.Enabled(ctx, slog.LevelDebug) {
+				return next(ctx, req)
+			}
+
+			start := time.Now()
+			resp, err := next(ctx, req)
+			latency := time.Since(start)
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("endpoint", req.Endpoint),
+				slog.String("function", req.FunctionName),
+				slog.Int("attempt", req.Attempt),
+				slog.Duration("latency", latency),
+				slog.Any("headers", redactHeader(req.Header)),
+			}
+			if bodyLimit != 0 && req.Body != nil {
+				attrs = append(attrs, slog.String("request_body", truncateBody(req.Body, bodyLimit)))
+			}
+			if resp != nil {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+				if bodyLimit != 0 {
+					attrs = append(attrs, slog.String("response_body", truncateBody(resp.Body, bodyLimit)))
+				}
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "meilisearch request", attrs...)
+			return resp, err
+		}
+	}
+}
+
+// redactHeader returns a copy of header without the value of the Authorization header
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	if redacted.Get("Authorization") != "" {
+		redacted.Set("Authorization", redactedHeaderValue)
+	}
+	return redacted
+}
+
+// truncateBody returns the body as a string, truncated to limit bytes when limit is positive
+func truncateBody(body []byte, limit int) string {
+	if limit < 0 || len(body) <= limit {
+		return string(body)
+	}
+	return string(body[:limit]) + "...(truncated)"
+}
@@ -0,0 +1,88 @@
+package meilisearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Logger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"taskUid":1,"indexUid":"movies","status":"enqueued","type":"documentAdditionOrUpdate"}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		level            slog.Level
+		bodyLimit        int
+		wantLogged       bool
+		wantRequestBody  interface{}
+		wantResponseBody interface{}
+	}{
+		{
+			name:       "TestLoggerWithoutBodies",
+			level:      slog.LevelDebug,
+			wantLogged: true,
+		},
+		{
+			name:             "TestLoggerWithTruncatedBodies",
+			level:            slog.LevelDebug,
+			bodyLimit:        8,
+			wantLogged:       true,
+			wantRequestBody:  `[{"id":1...(truncated)`,
+			wantResponseBody: `{"taskUi...(truncated)`,
+		},
+		{
+			name:             "TestLoggerWithFullBodies",
+			level:            slog.LevelDebug,
+			bodyLimit:        -1,
+			wantLogged:       true,
+			wantRequestBody:  `[{"id":1}]`,
+			wantResponseBody: `{"taskUid":1,"indexUid":"movies","status":"enqueued","type":"documentAdditionOrUpdate"}`,
+		},
+		{
+			name:       "TestLoggerAboveDebugLevel",
+			level:      slog.LevelInfo,
+			wantLogged: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			c := NewClient(ClientConfig{
+				Host:         server.URL,
+				APIKey:       masterKey,
+				Logger:       slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: tt.level})),
+				LogBodyLimit: tt.bodyLimit,
+			})
+
+			_, err := c.Index("movies").AddDocuments([]map[string]interface{}{{"id": 1}})
+			require.NoError(t, err)
+			if !tt.wantLogged {
+				require.Empty(t, buf.String())
+				return
+			}
+			require.NotContains(t, buf.String(), masterKey)
+
+			var got map[string]interface{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+			require.Equal(t, "DEBUG", got["level"])
+			require.Equal(t, "meilisearch request", got["msg"])
+			require.Equal(t, http.MethodPost, got["method"])
+			require.Equal(t, "/indexes/movies/documents", got["endpoint"])
+			require.Equal(t, "AddDocuments", got["function"])
+			require.Equal(t, float64(http.StatusAccepted), got["status"])
+			require.Contains(t, got, "latency")
+			require.Equal(t, []interface{}{redactedHeaderValue}, got["headers"].(map[string]interface{})["Authorization"])
+			require.Equal(t, tt.wantRequestBody, got["request_body"])
+			require.Equal(t, tt.wantResponseBody, got["response_body"])
+		})
+	}
+}
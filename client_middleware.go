@@ -0,0 +1,36 @@
+package meilisearch
+
+import "context"
+
+// Request is a request of the Client going through the ClientConfig.Middlewares before being sent by the
+// Transport. The Middlewares may change the method, URL, headers and body of the TransportRequest.
+type Request struct {
+	*TransportRequest
+
+	// Endpoint is the path of the request, without the host and the query parameters
+	Endpoint string
+
+	// FunctionName is the name of the method sending the request, e.g. "Search"
+	FunctionName string
+
+	// Attempt is 1 for the first attempt, greater when the request is retried following the ClientConfig.RetryPolicy
+	Attempt int
+}
+
+// RequestHandler sends a Request to Meilisearch.
+//
+// It returns an *Error when the request could not be sent or when the status code of the response
+// is not the one expected for the route, the response being returned alongside the error in the latter case.
+type RequestHandler func(ctx context.Context, req *Request) (*TransportResponse, error)
+
+// Middleware wraps the RequestHandler sending the requests of the Client, e.g. to add headers, sign,
+// log or meter the requests. It must call next to send the request, unless it answers the request itself.
+type Middleware func(next RequestHandler) RequestHandler
+
+// chainMiddlewares returns handler wrapped by the middlewares, the first one being the outermost
+func chainMiddlewares(middlewares []Middleware, handler RequestHandler) RequestHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
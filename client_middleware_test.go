@@ -0,0 +1,121 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Middlewares(t *testing.T) {
+	var gotHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Tenant")
+		if r.URL.Path == "/indexes/unknown/search" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index ` + "`unknown`" + ` not found.","code":"index_not_found","type":"invalid_request","link":""}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"hits":[],"query":"prince"}`))
+	}))
+	defer server.Close()
+
+	type call struct {
+		name         string
+		endpoint     string
+		functionName string
+		body         string
+		statusCode   int
+		err          error
+	}
+	var calls []call
+	record := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(ctx context.Context, req *Request) (*TransportResponse, error) {
+				req.Header.Set("X-Tenant", req.Header.Get("X-Tenant")+name)
+				resp, err := next(ctx, req)
+				c := call{
+					name:         name,
+					endpoint:     req.Endpoint,
+					functionName: req.FunctionName,
+					body:         string(req.Body),
+					err:          err,
+				}
+				if resp != nil {
+					c.statusCode = resp.StatusCode
+				}
+				calls = append(calls, c)
+				return resp, err
+			}
+		}
+	}
+
+	c := NewClient(ClientConfig{
+		Host:        server.URL,
+		Middlewares: []Middleware{record("a"), record("b")},
+	})
+
+	_, err := c.Index("movies").Search("prince", &SearchRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "ab", gotHeader)
+	require.Len(t, calls, 2)
+	require.Equal(t, "b", calls[0].name)
+	require.Equal(t, "a", calls[1].name)
+	require.Equal(t, "/indexes/movies/search", calls[1].endpoint)
+	require.Equal(t, "Search", calls[1].functionName)
+	require.JSONEq(t, `{"q":"prince"}`, calls[1].body)
+	require.Equal(t, http.StatusOK, calls[1].statusCode)
+
+	calls = nil
+	_, err = c.Index("unknown").Search("prince", &SearchRequest{})
+	require.ErrorIs(t, err, ErrIndexNotFound)
+	require.Len(t, calls, 2)
+	require.Equal(t, http.StatusNotFound, calls[1].statusCode)
+	require.ErrorIs(t, calls[1].err, ErrIndexNotFound)
+}
+
+func TestClient_MiddlewareAnsweringRequest(t *testing.T) {
+	c := NewClient(ClientConfig{
+		Host: "http://localhost:1",
+		Middlewares: []Middleware{
+			func(next RequestHandler) RequestHandler {
+				return func(ctx context.Context, req *Request) (*TransportResponse, error) {
+					if req.FunctionName == "Health" {
+						return &TransportResponse{StatusCode: http.StatusOK, Body: []byte(`{"status":"available"}`)}, nil
+					}
+					return next(ctx, req)
+				}
+			},
+		},
+	})
+
+	got, err := c.Health()
+	require.NoError(t, err)
+	require.Equal(t, "available", got.Status)
+
+	_, err = c.GetStats()
+	require.ErrorIs(t, err, ErrCommunication)
+}
+
+func TestClient_MiddlewaresOnEachAttempt(t *testing.T) {
+	var attempts []int
+	c := NewClient(ClientConfig{
+		Host:        "http://localhost:1",
+		RetryPolicy: &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond},
+		Middlewares: []Middleware{
+			func(next RequestHandler) RequestHandler {
+				return func(ctx context.Context, req *Request) (*TransportResponse, error) {
+					attempts = append(attempts, req.Attempt)
+					return next(ctx, req)
+				}
+			},
+		},
+	})
+
+	_, err := c.Health()
+	require.Error(t, err)
+	require.Equal(t, []int{1, 2, 3}, attempts)
+}
@@ -0,0 +1,87 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// clientAgentHeader is the header identifying the clients of Meilisearch in its analytics and logs
+const clientAgentHeader = "X-Meilisearch-Client"
+
+// RequestOption configures the requests sent with a context returned by WithRequestOptions
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	header       http.Header
+	apiKey       string
+	timeout      time.Duration
+	timeoutSet   bool
+	clientAgents []string
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx configuring the requests sent with it, in addition to the
+// options already set on ctx. It is given to the methods ending with WithContext:
+//
+//	ctx := meilisearch.WithRequestOptions(context.Background(), meilisearch.WithRequestHeader("X-Tenant", "acme"))
+//	resp, err := client.Index("movies").SearchWithContext(ctx, "wonder", &meilisearch.SearchRequest{})
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	options := requestOptions{header: http.Header{}}
+	if parent := requestOptionsFromContext(ctx); parent != nil {
+		options = *parent
+		options.header = parent.header.Clone()
+		options.clientAgents = append([]string(nil), parent.clientAgents...)
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, &options)
+}
+
+func requestOptionsFromContext(ctx context.Context) *requestOptions {
+	options, _ := ctx.Value(requestOptionsKey{}).(*requestOptions)
+	return options
+}
+
+// WithRequestHeader sets a header of the request, replacing the value set by the Client if any
+func WithRequestHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// WithRequestAPIKey sends the request with apiKey instead of ClientConfig.APIKey
+func WithRequestAPIKey(apiKey string) RequestOption {
+	return func(o *requestOptions) {
+		o.apiKey = apiKey
+	}
+}
+
+// WithRequestTimeout overrides ClientConfig.Timeout, a zero or negative timeout disables it
+// so the request is only bounded by its context
+func WithRequestTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+		o.timeoutSet = true
+	}
+}
+
+// WithClientAgents adds agents such as "My App (v1.2.3)" to the ClientConfig.ClientAgents of the request
+func WithClientAgents(agents ...string) RequestOption {
+	return func(o *requestOptions) {
+		o.clientAgents = append(o.clientAgents, agents...)
+	}
+}
+
+// clientAgents returns the value of the X-Meilisearch-Client header, the qualified version
+// of this library followed by the agents of the config and of the request
+func clientAgents(config []string, options *requestOptions) string {
+	agents := append([]string{GetQualifiedVersion()}, config...)
+	if options != nil {
+		agents = append(agents, options.clientAgents...)
+	}
+	return strings.Join(agents, " ; ")
+}
@@ -0,0 +1,101 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RequestOptions(t *testing.T) {
+	var gotHeader http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"status":"available"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{
+		Host:          server.URL,
+		APIKey:        masterKey,
+		CustomHeaders: map[string]string{"X-Tenant": "default", "X-Region": "eu"},
+		ClientAgents:  []string{"My App (v1.2.3)"},
+	})
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		wantHeader http.Header
+	}{
+		{
+			name: "TestRequestWithCustomHeaders",
+			ctx:  context.Background(),
+			wantHeader: http.Header{
+				"Authorization":        {"Bearer " + masterKey},
+				"X-Tenant":             {"default"},
+				"X-Region":             {"eu"},
+				"X-Meilisearch-Client": {GetQualifiedVersion() + " ; My App (v1.2.3)"},
+			},
+		},
+		{
+			name: "TestRequestWithOptions",
+			ctx: WithRequestOptions(context.Background(),
+				WithRequestHeader("X-Tenant", "acme"),
+				WithRequestAPIKey("tenantKey"),
+				WithClientAgents("Meilisearch Proxy (v0.1.0)"),
+			),
+			wantHeader: http.Header{
+				"Authorization":        {"Bearer tenantKey"},
+				"X-Tenant":             {"acme"},
+				"X-Region":             {"eu"},
+				"X-Meilisearch-Client": {GetQualifiedVersion() + " ; My App (v1.2.3) ; Meilisearch Proxy (v0.1.0)"},
+			},
+		},
+		{
+			name: "TestRequestWithNestedOptions",
+			ctx: WithRequestOptions(
+				WithRequestOptions(context.Background(), WithRequestHeader("X-Tenant", "acme")),
+				WithRequestHeader("X-Region", "us"),
+			),
+			wantHeader: http.Header{
+				"Authorization": {"Bearer " + masterKey},
+				"X-Tenant":      {"acme"},
+				"X-Region":      {"us"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.HealthWithContext(tt.ctx)
+			require.NoError(t, err)
+			for key, values := range tt.wantHeader {
+				require.Equal(t, values, gotHeader.Values(key), key)
+			}
+			require.Equal(t, GetQualifiedVersion(), gotHeader.Get("User-Agent"))
+		})
+	}
+
+}
+
+func TestClient_RequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientConfig{Host: server.URL})
+	_, err := c.GetStatsWithContext(WithRequestOptions(context.Background(), WithRequestTimeout(time.Millisecond)))
+	require.ErrorIs(t, err, ErrTimeout)
+
+	c = NewClient(ClientConfig{Host: server.URL, Timeout: time.Millisecond})
+	_, err = c.GetStats()
+	require.ErrorIs(t, err, ErrTimeout)
+	for _, timeout := range []time.Duration{0, -time.Second} {
+		_, err = c.GetStatsWithContext(WithRequestOptions(context.Background(), WithRequestTimeout(timeout)))
+		require.NoError(t, err, timeout)
+	}
+}
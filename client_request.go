@@ -1,13 +1,13 @@
 package meilisearch
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
-	"github.com/valyala/fasthttp"
-
 	"encoding/json"
 )
 
@@ -29,9 +29,33 @@ type internalRequest struct {
 	acceptedStatusCodes []int
 
 	functionName string
+
+	// readOnly is set on the POST requests that never enqueue a task, such as the searches,
+	// so that they are retried like the GET requests
+	readOnly bool
+}
+
+func (c *Client) executeRequest(ctx context.Context, req internalRequest) error {
+	for attempt := 1; ; attempt++ {
+		err := c.executeAttempt(ctx, req, attempt)
+		if err == nil {
+			return nil
+		}
+		internalError, ok := err.(*Error)
+		if !ok {
+			return err
+		}
+		internalError.Attempts = attempt
+		if !c.config.RetryPolicy.shouldRetry(ctx, &req, internalError, attempt) {
+			return internalError
+		}
+		if c.config.RetryPolicy.wait(ctx, attempt) != nil {
+			return internalError
+		}
+	}
 }
 
-func (c *Client) executeRequest(req internalRequest) error {
+func (c *Client) executeAttempt(ctx context.Context, req internalRequest, attempt int) error {
 	internalError := &Error{
 		Endpoint:         req.endpoint,
 		Method:           req.method,
@@ -44,15 +68,32 @@ func (c *Client) executeRequest(req internalRequest) error {
 		StatusCodeExpected: req.acceptedStatusCodes,
 	}
 
-	response := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(response)
-	err := c.sendRequest(&req, internalError, response)
+	options := requestOptionsFromContext(ctx)
+
+	timeout := c.config.Timeout
+	if options != nil && options.timeoutSet {
+		timeout = options.timeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	request, err := c.newTransportRequest(&req, options, internalError)
 	if err != nil {
 		return err
 	}
-	internalError.StatusCode = response.StatusCode()
 
-	err = c.handleStatusCode(&req, response, internalError)
+	send := func(ctx context.Context, r *Request) (*TransportResponse, error) {
+		return c.sendRequest(ctx, &req, r.TransportRequest, internalError)
+	}
+	response, err := chainMiddlewares(c.middlewares, send)(ctx, &Request{
+		TransportRequest: request,
+		Endpoint:         req.endpoint,
+		FunctionName:     req.functionName,
+		Attempt:          attempt,
+	})
 	if err != nil {
 		return err
 	}
@@ -64,17 +105,12 @@ func (c *Client) executeRequest(req internalRequest) error {
 	return nil
 }
 
-func (c *Client) sendRequest(req *internalRequest, internalError *Error, response *fasthttp.Response) error {
-	var (
-		request *fasthttp.Request
-
-		err error
-	)
-
+// newTransportRequest builds the request handed to the Middlewares and then to the Transport
+func (c *Client) newTransportRequest(req *internalRequest, options *requestOptions, internalError *Error) (*TransportRequest, error) {
 	// Setup URL
 	requestURL, err := url.Parse(c.config.Host + req.endpoint)
 	if err != nil {
-		return fmt.Errorf("unable to parse url: %w", err)
+		return nil, fmt.Errorf("unable to parse url: %w", err)
 	}
 
 	// Build query parameters
@@ -87,28 +123,28 @@ func (c *Client) sendRequest(req *internalRequest, internalError *Error, respons
 		requestURL.RawQuery = query.Encode()
 	}
 
-	request = fasthttp.AcquireRequest()
-	defer fasthttp.ReleaseRequest(request)
-
-	request.SetRequestURI(requestURL.String())
-	request.Header.SetMethod(req.method)
+	request := &TransportRequest{
+		Method: req.method,
+		URL:    requestURL.String(),
+		Header: http.Header{},
+	}
 
 	if req.withRequest != nil {
 		if req.method == http.MethodGet || req.method == http.MethodHead {
-			return fmt.Errorf("sendRequest: request body is not expected for GET and HEAD requests")
+			return nil, fmt.Errorf("sendRequest: request body is not expected for GET and HEAD requests")
 		}
 		if req.contentType == "" {
-			return fmt.Errorf("sendRequest: request body without Content-Type is not allowed")
+			return nil, fmt.Errorf("sendRequest: request body without Content-Type is not allowed")
 		}
 
 		rawRequest := req.withRequest
 		if bytes, ok := rawRequest.([]byte); ok {
 			// If the request body is already a []byte then use it directly
-			request.SetBody(bytes)
+			request.Body = bytes
 		} else if reader, ok := rawRequest.(io.Reader); ok {
 			// If the request body is an io.Reader then stream it directly until io.EOF
 			// NOTE: Avoid using this, due to problems with streamed request bodies
-			request.SetBodyStream(reader, -1)
+			request.BodyStream = reader
 		} else {
 			// Otherwise convert it to JSON
 			var (
@@ -122,9 +158,9 @@ func (c *Client) sendRequest(req *internalRequest, internalError *Error, respons
 			}
 			internalError.RequestToString = string(data)
 			if err != nil {
-				return internalError.WithErrCode(ErrCodeMarshalRequest, err)
+				return nil, internalError.WithErrCode(ErrCodeMarshalRequest, err)
 			}
-			request.SetBody(data)
+			request.Body = data
 		}
 	}
 
@@ -132,43 +168,76 @@ func (c *Client) sendRequest(req *internalRequest, internalError *Error, respons
 	if req.contentType != "" {
 		request.Header.Set("Content-Type", req.contentType)
 	}
-	if c.config.APIKey != "" {
-		request.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	apiKey := c.config.APIKey
+	if options != nil && options.apiKey != "" {
+		apiKey = options.apiKey
+	}
+	if apiKey != "" {
+		request.Header.Set("Authorization", "Bearer "+apiKey)
 	}
 
 	request.Header.Set("User-Agent", GetQualifiedVersion())
+	request.Header.Set(clientAgentHeader, clientAgents(c.config.ClientAgents, options))
 
-	// request is sent
-	if c.config.Timeout != 0 {
-		err = c.httpClient.DoTimeout(request, response, c.config.Timeout)
-	} else {
-		err = c.httpClient.Do(request, response)
+	for key, value := range c.config.CustomHeaders {
+		request.Header.Set(key, value)
 	}
+	if options != nil {
+		for key, values := range options.header {
+			request.Header[key] = append([]string(nil), values...)
+		}
+	}
+
+	return request, nil
+}
+
+// sendRequest is the last RequestHandler of the Middlewares chain, it sends the request with the Transport
+// and checks the status code of the response
+func (c *Client) sendRequest(ctx context.Context, req *internalRequest, request *TransportRequest, internalError *Error) (*TransportResponse, error) {
+	// request is sent
+	response, err := c.transport.Do(ctx, request)
 
 	// request execution timeout
-	if err == fasthttp.ErrTimeout {
-		return internalError.WithErrCode(MeilisearchTimeoutError, err)
+	if isTimeoutError(err) {
+		return nil, internalError.WithErrCode(MeilisearchTimeoutError, err)
 	}
 	// request execution fail
 	if err != nil {
-		return internalError.WithErrCode(MeilisearchCommunicationError, err)
+		return nil, internalError.WithErrCode(MeilisearchCommunicationError, err)
 	}
+	internalError.StatusCode = response.StatusCode
 
-	return nil
+	if err := c.handleStatusCode(req, response, internalError); err != nil {
+		return response, err
+	}
+	return response, nil
+}
+
+// isTimeoutError reports whether err comes from a request that timed out,
+// whatever the Transport that sent it.
+func isTimeoutError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var timeoutErr interface{ Timeout() bool }
+	return errors.As(err, &timeoutErr) && timeoutErr.Timeout()
 }
 
-func (c *Client) handleStatusCode(req *internalRequest, response *fasthttp.Response, internalError *Error) error {
+func (c *Client) handleStatusCode(req *internalRequest, response *TransportResponse, internalError *Error) error {
 	if req.acceptedStatusCodes != nil {
 
 		// A successful status code is required so check if the response status code is in the
 		// expected status code list.
 		for _, acceptedCode := range req.acceptedStatusCodes {
-			if response.StatusCode() == acceptedCode {
+			if response.StatusCode == acceptedCode {
 				return nil
 			}
 		}
 		// At this point the response status code is a failure.
-		rawBody := response.Body()
+		rawBody := response.Body
 
 		internalError.ErrorBody(rawBody)
 
@@ -181,11 +250,11 @@ func (c *Client) handleStatusCode(req *internalRequest, response *fasthttp.Respo
 	return nil
 }
 
-func (c *Client) handleResponse(req *internalRequest, response *fasthttp.Response, internalError *Error) (err error) {
+func (c *Client) handleResponse(req *internalRequest, response *TransportResponse, internalError *Error) (err error) {
 	if req.withResponse != nil {
 
 		// A json response is mandatory, so the response interface{} need to be unmarshal from the response payload.
-		rawBody := response.Body()
+		rawBody := response.Body
 		internalError.ResponseToString = string(rawBody)
 
 		var err error
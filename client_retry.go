@@ -0,0 +1,147 @@
+package meilisearch
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RetryPolicy configure how the Client retries the requests failing with a transient error
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the first attempt.
+	// Requests are never retried when it is lower than 2.
+	MaxAttempts int
+
+	// BaseBackoff is the delay before the first retry, it is doubled after each attempt
+	BaseBackoff time.Duration
+
+	// MaxBackoff is optional, it caps the delay between two attempts
+	MaxBackoff time.Duration
+
+	// Jitter is the fraction of each delay, between 0 and 1, that is randomly removed
+	// to avoid retrying many requests at the same time
+	Jitter float64
+
+	// RetryableErrCodes are the ErrCode of the errors to retry.
+	// Defaults to MeilisearchCommunicationError and MeilisearchTimeoutError when nil.
+	RetryableErrCodes []ErrCode
+
+	// RetryableStatusCodes are the response status codes to retry.
+	// Defaults to 502, 503 and 504 when nil.
+	RetryableStatusCodes []int
+
+	// RetryWriteMethods allows to retry POST, PUT, PATCH and DELETE requests. Only GET and HEAD requests, and the
+	// POST requests reading data such as the searches, are retried by default since Meilisearch may have enqueued
+	// a task even though the request failed: a retry can then enqueue a duplicate task, and return the TaskInfo
+	// of the duplicate instead of the original one.
+	RetryWriteMethods bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy sending each request that does not enqueue a task at most 3 times
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 100 * time.Millisecond,
+		MaxBackoff:  2 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+var (
+	defaultRetryableErrCodes    = []ErrCode{MeilisearchCommunicationError, MeilisearchTimeoutError}
+	defaultRetryableStatusCodes = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+)
+
+var (
+	retryRandMu sync.Mutex
+	retryRand   = rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+)
+
+// shouldRetry reports whether the request that failed with internalError at the given attempt can be sent again
+func (p *RetryPolicy) shouldRetry(ctx context.Context, req *internalRequest, internalError *Error, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+	if !p.RetryWriteMethods && !req.readOnly && !isReadMethod(req.method) {
+		return false
+	}
+	// A streamed body has already been consumed and cannot be sent again
+	if _, ok := req.withRequest.(io.Reader); ok {
+		return false
+	}
+
+	errCodes := p.RetryableErrCodes
+	if errCodes == nil {
+		errCodes = defaultRetryableErrCodes
+	}
+	for _, code := range errCodes {
+		if internalError.ErrCode == code {
+			return true
+		}
+	}
+
+	statusCodes := p.RetryableStatusCodes
+	if statusCodes == nil {
+		statusCodes = defaultRetryableStatusCodes
+	}
+	if internalError.ErrCode == MeilisearchApiError || internalError.ErrCode == MeilisearchApiErrorWithoutMessage {
+		for _, code := range statusCodes {
+			if internalError.StatusCode == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// backoff returns the delay to wait after the given attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		// Stop doubling before the delay overflows when there is no MaxBackoff
+		if delay > math.MaxInt64/2 {
+			delay = math.MaxInt64
+			break
+		}
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		retryRandMu.Lock()
+		r := retryRand.Float64()
+		retryRandMu.Unlock()
+		delay -= time.Duration(float64(delay) * math.Min(p.Jitter, 1) * r)
+	}
+	return delay
+}
+
+// wait blocks for the backoff of the given attempt, or until ctx is done
+func (p *RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.backoff(attempt))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isReadMethod reports whether requests with the given method never enqueue a task
+func isReadMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	default:
+		return false
+	}
+}
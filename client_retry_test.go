@@ -0,0 +1,255 @@
+package meilisearch
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RetryPolicy(t *testing.T) {
+	type args struct {
+		policy     *RetryPolicy
+		method     string
+		statusCode int
+		failures   int32
+		// search sends the request as Index.Search does
+		search bool
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantErr      bool
+		wantAttempts int32
+	}{
+		{
+			name: "TestRetryUntilSuccess",
+			args: args{
+				policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond},
+				method:     http.MethodGet,
+				statusCode: http.StatusServiceUnavailable,
+				failures:   2,
+			},
+			wantAttempts: 3,
+		},
+		{
+			name: "TestRetryUntilMaxAttempts",
+			args: args{
+				policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, Jitter: 0.5},
+				method:     http.MethodHead,
+				statusCode: http.StatusBadGateway,
+				failures:   5,
+			},
+			wantErr:      true,
+			wantAttempts: 3,
+		},
+		{
+			name: "TestNoRetryWithoutPolicy",
+			args: args{
+				method:     http.MethodGet,
+				statusCode: http.StatusServiceUnavailable,
+				failures:   1,
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name: "TestNoRetryOnNonRetryableStatusCode",
+			args: args{
+				policy:     DefaultRetryPolicy(),
+				method:     http.MethodGet,
+				statusCode: http.StatusBadRequest,
+				failures:   1,
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name: "TestNoRetryOnWriteMethod",
+			args: args{
+				policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond},
+				method:     http.MethodPost,
+				statusCode: http.StatusServiceUnavailable,
+				failures:   1,
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name: "TestNoRetryOnDeleteMethod",
+			args: args{
+				policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond},
+				method:     http.MethodDelete,
+				statusCode: http.StatusServiceUnavailable,
+				failures:   1,
+			},
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name: "TestRetryPostSearch",
+			args: args{
+				policy:     DefaultRetryPolicy(),
+				method:     http.MethodPost,
+				statusCode: http.StatusBadGateway,
+				failures:   1,
+				search:     true,
+			},
+			wantAttempts: 2,
+		},
+		{
+			name: "TestRetryWriteMethod",
+			args: args{
+				policy:     &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, RetryWriteMethods: true},
+				method:     http.MethodPost,
+				statusCode: http.StatusGatewayTimeout,
+				failures:   1,
+			},
+			wantAttempts: 2,
+		},
+		{
+			name: "TestRetryCustomStatusCode",
+			args: args{
+				policy: &RetryPolicy{
+					MaxAttempts:          2,
+					BaseBackoff:          time.Millisecond,
+					RetryableStatusCodes: []int{http.StatusTooManyRequests},
+				},
+				method:     http.MethodGet,
+				statusCode: http.StatusTooManyRequests,
+				failures:   1,
+			},
+			wantAttempts: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) <= tt.args.failures {
+					w.WriteHeader(tt.args.statusCode)
+					return
+				}
+				_, _ = w.Write([]byte(`{"status":"available"}`))
+			}))
+			defer server.Close()
+
+			c := NewClient(ClientConfig{
+				Host:        server.URL,
+				RetryPolicy: tt.args.policy,
+			})
+			req := internalRequest{
+				endpoint:            "/health",
+				method:              tt.args.method,
+				withResponse:        &Health{},
+				acceptedStatusCodes: []int{http.StatusOK},
+				functionName:        "Health",
+			}
+			if tt.args.method == http.MethodPost {
+				req.contentType = contentTypeJSON
+				req.withRequest = map[string]string{}
+			}
+			if tt.args.search {
+				req.endpoint = "/indexes/movies/search"
+				req.withResponse = &SearchResponse{}
+				req.functionName = "Search"
+				req.readOnly = true
+			}
+
+			err := c.executeRequest(context.Background(), req)
+			require.Equal(t, tt.wantAttempts, atomic.LoadInt32(&attempts))
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Equal(t, int(tt.wantAttempts), err.(*Error).Attempts)
+				require.Equal(t, tt.args.statusCode, err.(*Error).StatusCode)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_RetryPolicyOnReadOnlyPostRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(c *Client) error
+	}{
+		{
+			name: "TestRetrySearch",
+			call: func(c *Client) error {
+				_, err := c.Index("movies").Search("prince", &SearchRequest{})
+				return err
+			},
+		},
+		{
+			name: "TestRetryMultiSearch",
+			call: func(c *Client) error {
+				_, err := c.MultiSearch(&MultiSearchRequest{Queries: []SearchRequest{{IndexUID: "movies"}}})
+				return err
+			},
+		},
+		{
+			name: "TestRetryGetDocumentsWithFilter",
+			call: func(c *Client) error {
+				return c.Index("movies").GetDocuments(&DocumentsQuery{Filter: "id = 1"}, &DocumentsResult{})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			c := NewClient(ClientConfig{
+				Host:        server.URL,
+				RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseBackoff: time.Millisecond},
+			})
+			require.NoError(t, tt.call(c))
+			require.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestClient_RetryPolicyOnCommunicationError(t *testing.T) {
+	c := NewClient(ClientConfig{
+		Host:        "http://localhost:1",
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, BaseBackoff: time.Millisecond},
+	})
+
+	_, err := c.Health()
+	require.Error(t, err)
+	require.Equal(t, MeilisearchCommunicationError, err.(*Error).ErrCode)
+	require.Equal(t, 2, err.(*Error).Attempts)
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	require.Equal(t, 100*time.Millisecond, p.backoff(1))
+	require.Equal(t, 200*time.Millisecond, p.backoff(2))
+	require.Equal(t, 400*time.Millisecond, p.backoff(3))
+	require.Equal(t, time.Second, p.backoff(5))
+	require.Equal(t, time.Second, p.backoff(50))
+
+	p = &RetryPolicy{BaseBackoff: 100 * time.Millisecond}
+	require.Equal(t, 800*time.Millisecond, p.backoff(4))
+	require.Equal(t, time.Duration(math.MaxInt64), p.backoff(100))
+
+	p.Jitter = 0.5
+	for attempt := 1; attempt < 5; attempt++ {
+		delay := p.backoff(attempt)
+		require.LessOrEqual(t, int64(delay), int64(time.Second))
+		require.GreaterOrEqual(t, int64(delay), int64(50*time.Millisecond))
+	}
+}
@@ -0,0 +1,139 @@
+package meilisearch
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// TasksIterator pages through the tasks matching a TasksQuery, from the most
+// recent to the oldest, following the `next` cursor returned by Meilisearch.
+//
+//	it := client.IterateTasks(&meilisearch.TasksQuery{Statuses: []string{"failed"}})
+//	defer it.Close()
+//	for it.Next() {
+//		task := it.Task()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type TasksIterator struct {
+	ctx    context.Context
+	client *Client
+	query  TasksQuery
+
+	page     []Task
+	position int
+	// next is the uid of the first task of the next page, nil once the last page is fetched
+	next    *int64
+	started bool
+	closed  bool
+	err     error
+}
+
+// tasksPage is a TaskResult telling apart the last page, where next is null
+type tasksPage struct {
+	Results []Task `json:"results"`
+	Next    *int64 `json:"next"`
+}
+
+// IterateTasks returns an iterator over the tasks matching the query. TasksQuery.From is the uid of the
+// first task, and TasksQuery.Limit the number of tasks fetched per request, DefaultIteratorPageSize if not set.
+func (c *Client) IterateTasks(query *TasksQuery) *TasksIterator {
+	return c.IterateTasksWithContext(context.Background(), query)
+}
+
+// IterateTasksWithContext is IterateTasks with the iteration stopping
+// with the error of ctx once it is done.
+func (c *Client) IterateTasksWithContext(ctx context.Context, query *TasksQuery) *TasksIterator {
+	it := &TasksIterator{
+		ctx:      ctx,
+		client:   c,
+		position: -1,
+	}
+	if query != nil {
+		it.query = *query
+	}
+	if it.query.Limit == 0 {
+		it.query.Limit = DefaultIteratorPageSize
+	}
+	return it
+}
+
+// IterateTasks returns an iterator over the tasks of the index matching the query, see Client.IterateTasks.
+func (i Index) IterateTasks(query *TasksQuery) *TasksIterator {
+	return i.IterateTasksWithContext(context.Background(), query)
+}
+
+func (i Index) IterateTasksWithContext(ctx context.Context, query *TasksQuery) *TasksIterator {
+	indexQuery := TasksQuery{}
+	if query != nil {
+		indexQuery = *query
+	}
+	indexQuery.IndexUIDS = append(append([]string{}, indexQuery.IndexUIDS...), i.UID)
+	return i.client.IterateTasksWithContext(ctx, &indexQuery)
+}
+
+// Next advances the iterator to the next task, fetching the next page of
+// tasks when needed. It returns false when there are no more tasks, the
+// iterator is closed or an error occurred.
+func (it *TasksIterator) Next() bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+	it.position++
+	if it.position < len(it.page) {
+		return true
+	}
+	if it.started && it.next == nil {
+		it.page = nil
+		return false
+	}
+
+	page := &tasksPage{}
+	req := internalRequest{
+		endpoint:            "/tasks",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        page,
+		withQueryParams:     map[string]string{},
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "IterateTasks",
+	}
+	encodeTasksQuery(&it.query, &req)
+	if it.started {
+		// Set even when next is 0, which encodeTasksQuery would skip
+		req.withQueryParams["from"] = strconv.FormatInt(*it.next, 10)
+	}
+	if err := it.client.executeRequest(it.ctx, req); err != nil {
+		it.err = err
+		it.page = nil
+		return false
+	}
+
+	it.started = true
+	it.page = page.Results
+	it.position = 0
+	it.next = page.Next
+	return len(it.page) > 0
+}
+
+// Task returns the current task
+func (it *TasksIterator) Task() Task {
+	if it.position < 0 || it.position >= len(it.page) {
+		return Task{}
+	}
+	return it.page[it.position]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *TasksIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration early, Next returns false afterwards
+func (it *TasksIterator) Close() {
+	it.closed = true
+	it.page = nil
+}
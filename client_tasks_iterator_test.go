@@ -0,0 +1,146 @@
+package meilisearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_IterateTasks(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+		query  *TasksQuery
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantCount int
+	}{
+		{
+			name: "TestClientBasicIterateTasks",
+			args: args{
+				UID:    "TestClientBasicIterateTasks",
+				client: defaultClient,
+				query: &TasksQuery{
+					IndexUIDS: []string{"TestClientBasicIterateTasks"},
+				},
+			},
+			wantCount: 3,
+		},
+		{
+			name: "TestClientIterateTasksWithSeveralPages",
+			args: args{
+				UID:    "TestClientIterateTasksWithSeveralPages",
+				client: customClient,
+				query: &TasksQuery{
+					IndexUIDS: []string{"TestClientIterateTasksWithSeveralPages"},
+					Limit:     1,
+				},
+			},
+			wantCount: 3,
+		},
+		{
+			name: "TestClientIterateTasksWithTypes",
+			args: args{
+				UID:    "TestClientIterateTasksWithTypes",
+				client: defaultClient,
+				query: &TasksQuery{
+					IndexUIDS: []string{"TestClientIterateTasksWithTypes"},
+					Types:     []string{"documentAdditionOrUpdate"},
+					Limit:     2,
+				},
+			},
+			wantCount: 3,
+		},
+		{
+			name: "TestClientIterateTasksWithoutMatchingTask",
+			args: args{
+				UID:    "TestClientIterateTasksWithoutMatchingTask",
+				client: defaultClient,
+				query: &TasksQuery{
+					IndexUIDS: []string{"TestClientIterateTasksWithoutMatchingTask"},
+					Types:     []string{"indexDeletion"},
+				},
+			},
+			wantCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+			for j := 0; j < 3; j++ {
+				task, err := i.AddDocuments([]docTest{{ID: "123", Name: "Pride and Prejudice"}})
+				require.NoError(t, err)
+				testWaitForTask(t, i, task)
+			}
+
+			it := c.IterateTasks(tt.args.query)
+			defer it.Close()
+
+			count := 0
+			previousUID := int64(-1)
+			for it.Next() {
+				task := it.Task()
+				require.Equal(t, tt.args.UID, task.IndexUID)
+				if previousUID != -1 {
+					require.Less(t, task.UID, previousUID)
+				}
+				previousUID = task.UID
+				count++
+			}
+			require.NoError(t, it.Err())
+			require.Equal(t, tt.wantCount, count)
+		})
+	}
+}
+
+func TestIndex_IterateTasks(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexIterateTasks")
+	t.Cleanup(cleanup(c))
+	for j := 0; j < 3; j++ {
+		task, err := i.AddDocuments([]docTest{{ID: "123", Name: "Pride and Prejudice"}})
+		require.NoError(t, err)
+		testWaitForTask(t, i, task)
+	}
+
+	query := &TasksQuery{Limit: 2}
+	it := i.IterateTasks(query)
+	count := 0
+	for it.Next() {
+		require.Equal(t, "TestIndexIterateTasks", it.Task().IndexUID)
+		count++
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, 3, count)
+	require.Empty(t, query.IndexUIDS)
+}
+
+func TestClient_IterateTasksStopEarly(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestClientIterateTasksStopEarly")
+	t.Cleanup(cleanup(c))
+	for j := 0; j < 2; j++ {
+		task, err := i.AddDocuments([]docTest{{ID: "123", Name: "Pride and Prejudice"}})
+		require.NoError(t, err)
+		testWaitForTask(t, i, task)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := i.IterateTasksWithContext(ctx, &TasksQuery{Limit: 1})
+	require.True(t, it.Next())
+
+	cancel()
+	require.False(t, it.Next())
+	require.ErrorIs(t, it.Err().(*Error).OriginError, context.Canceled)
+
+	it = i.IterateTasks(&TasksQuery{Limit: 1})
+	require.True(t, it.Next())
+	it.Close()
+	require.False(t, it.Next())
+	require.NoError(t, it.Err())
+}
@@ -2,9 +2,12 @@ package meilisearch
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,6 +29,10 @@ func TestClient_Version(t *testing.T) {
 			name:   "TestVersionWithCustomClient",
 			client: customClient,
 		},
+		{
+			name:   "TestVersionWithNetHTTPClient",
+			client: netHTTPClient,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -61,6 +68,52 @@ func TestClient_TimeoutError(t *testing.T) {
 	}
 }
 
+func TestClient_VersionWithContext(t *testing.T) {
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	expiredCtx, cancelExpired := context.WithTimeout(context.Background(), -time.Second)
+	defer cancelExpired()
+
+	tests := []struct {
+		name    string
+		client  *Client
+		ctx     context.Context
+		wantErr ErrCode
+	}{
+		{
+			name:   "TestVersionWithBackgroundContext",
+			client: defaultClient,
+			ctx:    context.Background(),
+		},
+		{
+			name:    "TestVersionWithCanceledContext",
+			client:  defaultClient,
+			ctx:     canceledCtx,
+			wantErr: MeilisearchCommunicationError,
+		},
+		{
+			name:    "TestVersionWithExpiredContext",
+			client:  customClient,
+			ctx:     expiredCtx,
+			wantErr: MeilisearchTimeoutError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResp, err := tt.client.VersionWithContext(tt.ctx)
+			if tt.wantErr != ErrCodeUnknown {
+				require.Error(t, err)
+				require.Nil(t, gotResp)
+				require.Equal(t, tt.wantErr, err.(*Error).ErrCode)
+				require.ErrorIs(t, err.(*Error).OriginError, tt.ctx.Err())
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, gotResp)
+		})
+	}
+}
+
 func TestClient_GetStats(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -489,6 +542,14 @@ func TestClient_Health(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "TestHealthWithNetHTTPClient",
+			client: netHTTPClient,
+			wantResp: &Health{
+				Status: "available",
+			},
+			wantErr: false,
+		},
 		{
 			name: "TestHealthWithBadUrl",
 			client: &Client{
@@ -496,9 +557,9 @@ func TestClient_Health(t *testing.T) {
 					Host:   "http://wrongurl:1234",
 					APIKey: masterKey,
 				},
-				httpClient: &fasthttp.Client{
+				transport: NewFastHTTPTransport(&fasthttp.Client{
 					Name: "meilisearch-client",
-				},
+				}),
 			},
 			wantErr: true,
 		},
@@ -539,9 +600,9 @@ func TestClient_IsHealthy(t *testing.T) {
 					Host:   "http://wrongurl:1234",
 					APIKey: masterKey,
 				},
-				httpClient: &fasthttp.Client{
+				transport: NewFastHTTPTransport(&fasthttp.Client{
 					Name: "meilisearch-client",
-				},
+				}),
 			},
 			want: false,
 		},
@@ -1147,6 +1208,197 @@ func TestClient_SwapIndexes(t *testing.T) {
 	}
 }
 
+func TestClient_MultiSearch(t *testing.T) {
+	type args struct {
+		client  *Client
+		queries *MultiSearchRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		want []SearchResponse
+	}{
+		{
+			name: "TestMultiSearchOneIndex",
+			args: args{
+				client: defaultClient,
+				queries: &MultiSearchRequest{
+					Queries: []SearchRequest{
+						{IndexUID: "indexUID", Query: "wonder"},
+					},
+				},
+			},
+			want: []SearchResponse{
+				{
+					IndexUID:           "indexUID",
+					Hits:               []interface{}{map[string]interface{}{"book_id": float64(1), "title": "Alice In Wonderland"}},
+					EstimatedTotalHits: 1,
+					Limit:              20,
+					Query:              "wonder",
+				},
+			},
+		},
+		{
+			name: "TestMultiSearchMultipleIndexes",
+			args: args{
+				client: defaultClient,
+				queries: &MultiSearchRequest{
+					Queries: []SearchRequest{
+						{IndexUID: "indexUID", Query: "wonder"},
+						{IndexUID: "TestMultiSearchMultipleIndexes", Query: "prince", Limit: 1},
+					},
+				},
+			},
+			want: []SearchResponse{
+				{
+					IndexUID:           "indexUID",
+					Hits:               []interface{}{map[string]interface{}{"book_id": float64(1), "title": "Alice In Wonderland"}},
+					EstimatedTotalHits: 1,
+					Limit:              20,
+					Query:              "wonder",
+				},
+				{
+					IndexUID:           "TestMultiSearchMultipleIndexes",
+					Hits:               []interface{}{map[string]interface{}{"book_id": float64(456), "title": "Le Petit Prince"}},
+					EstimatedTotalHits: 2,
+					Limit:              1,
+					Query:              "prince",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			t.Cleanup(cleanup(c))
+			SetUpBasicIndex("indexUID")
+			SetUpBasicIndex(tt.name)
+
+			got, err := c.MultiSearch(tt.args.queries)
+			require.NoError(t, err)
+			require.Len(t, got.Results, len(tt.want))
+			for i := range got.Results {
+				require.Equal(t, tt.want[i].IndexUID, got.Results[i].IndexUID)
+				require.Equal(t, tt.want[i].Hits, got.Results[i].Hits)
+				require.Equal(t, tt.want[i].EstimatedTotalHits, got.Results[i].EstimatedTotalHits)
+				require.Equal(t, tt.want[i].Limit, got.Results[i].Limit)
+				require.Equal(t, tt.want[i].Query, got.Results[i].Query)
+			}
+		})
+	}
+}
+
+func TestClient_MultiSearchWithUnknownIndex(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+
+	got, err := c.MultiSearch(&MultiSearchRequest{
+		Queries: []SearchRequest{
+			{IndexUID: "TestMultiSearchWithUnknownIndex", Query: "prince"},
+		},
+	})
+	require.Error(t, err)
+	require.Nil(t, got)
+	require.Equal(t, "index_not_found", err.(*Error).MeilisearchApiError.Code)
+	require.ErrorIs(t, err, ErrIndexNotFound)
+	require.True(t, IsNotFound(err))
+}
+
+func TestClient_FederatedMultiSearch(t *testing.T) {
+	type args struct {
+		client  *Client
+		queries *MultiSearchRequest
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantTitles []string
+		wantLimit  int64
+		wantOffset int64
+	}{
+		{
+			name: "TestFederatedMultiSearch",
+			args: args{
+				client: defaultClient,
+				queries: &MultiSearchRequest{
+					Queries: []SearchRequest{
+						{IndexUID: "indexUID", Query: "wonder"},
+						{IndexUID: "TestFederatedMultiSearch", Query: "hobbit"},
+					},
+				},
+			},
+			wantTitles: []string{"The Hobbit", "Alice In Wonderland"},
+			wantLimit:  20,
+		},
+		{
+			name: "TestFederatedMultiSearchWithWeightAndLimit",
+			args: args{
+				client: defaultClient,
+				queries: &MultiSearchRequest{
+					Federation: &MultiSearchFederation{Limit: 1},
+					Queries: []SearchRequest{
+						{IndexUID: "indexUID", Query: "wonder"},
+						{
+							IndexUID:          "TestFederatedMultiSearchWithWeightAndLimit",
+							Query:             "hobbit",
+							FederationOptions: &FederationOptions{Weight: 10},
+						},
+					},
+				},
+			},
+			wantTitles: []string{"The Hobbit"},
+			wantLimit:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			t.Cleanup(cleanup(c))
+			SetUpBasicIndex("indexUID")
+			SetUpBasicIndex(tt.name)
+
+			got, err := c.FederatedMultiSearch(tt.args.queries)
+			require.NoError(t, err)
+			require.Len(t, got.Hits, len(tt.wantTitles))
+			require.Equal(t, tt.wantLimit, got.Limit)
+			require.Equal(t, tt.wantOffset, got.Offset)
+
+			// The hits of all the queries are merged by descending weighted ranking score
+			federations := got.HitsFederation()
+			gotTitles := make([]string, len(got.Hits))
+			for i, hit := range got.Hits {
+				gotTitles[i], _ = hit.(map[string]interface{})["title"].(string)
+				query := tt.args.queries.Queries[federations[i].QueriesPosition]
+				require.Equal(t, query.IndexUID, federations[i].IndexUID)
+				if i > 0 {
+					require.GreaterOrEqual(t, federations[i-1].WeightedRankingScore, federations[i].WeightedRankingScore)
+				}
+			}
+			require.ElementsMatch(t, tt.wantTitles, gotTitles)
+		})
+	}
+}
+
+func TestClient_WaitForTaskWithContextWithoutInterval(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := TaskStatusEnqueued
+		if atomic.AddInt32(&calls, 1) >= 3 {
+			status = TaskStatusSucceeded
+		}
+		_, _ = w.Write([]byte(`{"uid":1,"status":"` + string(status) + `"}`))
+	}))
+	defer server.Close()
+	c := NewClient(ClientConfig{Host: server.URL})
+
+	start := time.Now()
+	got, err := c.WaitForTaskWithContext(context.Background(), 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusSucceeded, got.Status)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	require.GreaterOrEqual(t, time.Since(start), 2*defaultWaitInterval)
+}
+
 func TestClient_DefaultWaitForTask(t *testing.T) {
 	type args struct {
 		UID      string
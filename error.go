@@ -2,7 +2,9 @@ package meilisearch
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
@@ -55,6 +57,59 @@ func (e ErrCode) rawMessage() string {
 	}
 }
 
+// Sentinel errors matching the errors returned by this library with errors.Is, e.g.
+// errors.Is(err, ErrIndexNotFound) is true when Meilisearch answered with the index_not_found code.
+var (
+	// ErrIndexNotFound the index does not exist
+	ErrIndexNotFound = errors.New("meilisearch: index not found")
+	// ErrIndexAlreadyExists an index with the same uid already exists
+	ErrIndexAlreadyExists = errors.New("meilisearch: index already exists")
+	// ErrDocumentNotFound the document does not exist
+	ErrDocumentNotFound = errors.New("meilisearch: document not found")
+	// ErrTaskNotFound the task does not exist
+	ErrTaskNotFound = errors.New("meilisearch: task not found")
+	// ErrAPIKeyNotFound the API key does not exist
+	ErrAPIKeyNotFound = errors.New("meilisearch: api key not found")
+	// ErrInvalidAPIKey the API key of the request is invalid or lacks the permissions for the route
+	ErrInvalidAPIKey = errors.New("meilisearch: invalid api key")
+	// ErrMissingAuthorizationHeader the instance is protected and the request has no API key
+	ErrMissingAuthorizationHeader = errors.New("meilisearch: missing authorization header")
+	// ErrInvalidFilter the filter is invalid or uses attributes that are not filterable
+	ErrInvalidFilter = errors.New("meilisearch: invalid filter")
+	// ErrInvalidSort the sort is invalid or uses attributes that are not sortable
+	ErrInvalidSort = errors.New("meilisearch: invalid sort")
+	// ErrInvalidDocumentID a document identifier is not a string or an integer made of alphanumeric characters, - and _
+	ErrInvalidDocumentID = errors.New("meilisearch: invalid document id")
+	// ErrMissingDocumentID a document has no value for the primary key of the index
+	ErrMissingDocumentID = errors.New("meilisearch: missing document id")
+	// ErrPayloadTooLarge the request body exceeds the payload limit of the instance
+	ErrPayloadTooLarge = errors.New("meilisearch: payload too large")
+	// ErrTimeout the request timed out, see MeilisearchTimeoutError
+	ErrTimeout = errors.New("meilisearch: timeout")
+	// ErrCommunication the request could not be sent, see MeilisearchCommunicationError
+	ErrCommunication = errors.New("meilisearch: communication error")
+)
+
+// apiErrorsByCode maps the codes of the Meilisearch error catalogue to their sentinel error
+var apiErrorsByCode = map[string]error{
+	"index_not_found":              ErrIndexNotFound,
+	"index_already_exists":         ErrIndexAlreadyExists,
+	"document_not_found":           ErrDocumentNotFound,
+	"task_not_found":               ErrTaskNotFound,
+	"api_key_not_found":            ErrAPIKeyNotFound,
+	"invalid_api_key":              ErrInvalidAPIKey,
+	"missing_authorization_header": ErrMissingAuthorizationHeader,
+	"invalid_search_filter":        ErrInvalidFilter,
+	"invalid_document_filter":      ErrInvalidFilter,
+	"invalid_similar_filter":       ErrInvalidFilter,
+	"invalid_task_filter":          ErrInvalidFilter,
+	"invalid_search_sort":          ErrInvalidSort,
+	"invalid_document_sort":        ErrInvalidSort,
+	"invalid_document_id":          ErrInvalidDocumentID,
+	"missing_document_id":          ErrMissingDocumentID,
+	"payload_too_large":            ErrPayloadTooLarge,
+}
+
 type meilisearchApiError struct {
 	Message string `json:"message"`
 	Code    string `json:"code"`
@@ -98,6 +153,10 @@ type Error struct {
 	// ErrCode is the internal error code that represent the different step when executing a request that can produce
 	// an error.
 	ErrCode ErrCode
+
+	// Attempts is the number of times the request was sent before failing, greater than 1 when it was retried
+	// following the ClientConfig.RetryPolicy.
+	Attempts int
 }
 
 // Error return a well human formatted message.
@@ -122,6 +181,24 @@ func (e Error) Error() string {
 	return message
 }
 
+// Is reports whether target is the sentinel error matching the code sent by Meilisearch,
+// or ErrTimeout and ErrCommunication for the requests that could not complete.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrTimeout:
+		return e.ErrCode == MeilisearchTimeoutError
+	case ErrCommunication:
+		return e.ErrCode == MeilisearchCommunicationError
+	}
+	sentinel, ok := apiErrorsByCode[e.MeilisearchApiError.Code]
+	return ok && sentinel == target
+}
+
+// Unwrap returns the OriginError, so that errors.Is and errors.As also inspect the cause of the error
+func (e Error) Unwrap() error {
+	return e.OriginError
+}
+
 // WithErrCode add an error code to an error
 func (e *Error) WithErrCode(err ErrCode, errs ...error) *Error {
 	if errs != nil {
@@ -146,6 +223,28 @@ func (e *Error) ErrorBody(body []byte) {
 	}
 }
 
+// IsNotFound reports whether err was returned because the index, document, task or key does not exist
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// IsAuthError reports whether err was returned because the API key is missing, invalid or not allowed on the route
+func IsAuthError(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && (e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden)
+}
+
+// IsInvalidFilter reports whether err was returned because of an invalid filter
+func IsInvalidFilter(err error) bool {
+	return errors.Is(err, ErrInvalidFilter)
+}
+
+// IsTimeout reports whether err was returned because the request timed out
+func IsTimeout(err error) bool {
+	return errors.Is(err, ErrTimeout)
+}
+
 func namedSprintf(format string, params map[string]interface{}) string {
 	for key, val := range params {
 		format = strings.ReplaceAll(format, "${"+key+"}", fmt.Sprintf("%v", val))
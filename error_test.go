@@ -0,0 +1,112 @@
+package meilisearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       *Error
+		target    error
+		want      bool
+		wantFound bool
+		wantAuth  bool
+	}{
+		{
+			name: "TestErrorIsIndexNotFound",
+			err: (&Error{
+				StatusCode:          http.StatusNotFound,
+				MeilisearchApiError: meilisearchApiError{Code: "index_not_found"},
+			}).WithErrCode(MeilisearchApiError),
+			target:    ErrIndexNotFound,
+			want:      true,
+			wantFound: true,
+		},
+		{
+			name: "TestErrorIsNotAnotherCode",
+			err: (&Error{
+				StatusCode:          http.StatusNotFound,
+				MeilisearchApiError: meilisearchApiError{Code: "document_not_found"},
+			}).WithErrCode(MeilisearchApiError),
+			target:    ErrIndexNotFound,
+			want:      false,
+			wantFound: true,
+		},
+		{
+			name: "TestErrorIsInvalidFilter",
+			err: (&Error{
+				StatusCode:          http.StatusBadRequest,
+				MeilisearchApiError: meilisearchApiError{Code: "invalid_document_filter"},
+			}).WithErrCode(MeilisearchApiError),
+			target: ErrInvalidFilter,
+			want:   true,
+		},
+		{
+			name: "TestErrorIsInvalidAPIKey",
+			err: (&Error{
+				StatusCode:          http.StatusForbidden,
+				MeilisearchApiError: meilisearchApiError{Code: "invalid_api_key"},
+			}).WithErrCode(MeilisearchApiError),
+			target:   ErrInvalidAPIKey,
+			want:     true,
+			wantAuth: true,
+		},
+		{
+			name:   "TestErrorIsTimeout",
+			err:    (&Error{}).WithErrCode(MeilisearchTimeoutError, context.DeadlineExceeded),
+			target: ErrTimeout,
+			want:   true,
+		},
+		{
+			name:   "TestErrorIsUnwrappedOriginError",
+			err:    (&Error{}).WithErrCode(MeilisearchTimeoutError, context.DeadlineExceeded),
+			target: context.DeadlineExceeded,
+			want:   true,
+		},
+		{
+			name:   "TestErrorIsCommunication",
+			err:    (&Error{}).WithErrCode(MeilisearchCommunicationError, errors.New("connection refused")),
+			target: ErrCommunication,
+			want:   true,
+		},
+		{
+			name:   "TestErrorIsNotTimeout",
+			err:    (&Error{}).WithErrCode(MeilisearchCommunicationError, errors.New("connection refused")),
+			target: ErrTimeout,
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error = fmt.Errorf("wrapped: %w", tt.err)
+			require.Equal(t, tt.want, errors.Is(err, tt.target))
+			require.Equal(t, tt.wantFound, IsNotFound(err))
+			require.Equal(t, tt.wantAuth, IsAuthError(err))
+		})
+	}
+}
+
+func TestError_IsFromResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Attribute ` + "`title`" + ` is not filterable.","code":"invalid_search_filter","type":"invalid_request","link":"https://docs.meilisearch.com/errors#invalid_search_filter"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(ClientConfig{Host: srv.URL})
+	_, err := c.Index("indexUID").Search("", &SearchRequest{Filter: "title = x"})
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrInvalidFilter)
+	require.True(t, IsInvalidFilter(err))
+	require.False(t, IsNotFound(err))
+	require.False(t, IsTimeout(err))
+}
@@ -0,0 +1,525 @@
+// Package filter builds Meilisearch filter expressions.
+//
+// Expressions render to the Meilisearch filter syntax with values quoted and
+// escaped, and can be used wherever the client takes a filter, such as
+// SearchRequest.Filter:
+//
+//	request := &meilisearch.SearchRequest{
+//		Filter: filter.And(
+//			filter.Eq("genre", `rock "n" roll`),
+//			filter.Between("year", 1970, 1979),
+//			filter.Not(filter.IsEmpty("cover")),
+//		),
+//	}
+//
+// Documentation: https://www.meilisearch.com/docs/learn/filtering_and_sorting/filter_expression_reference
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Expression is a filter expression. String returns it in the Meilisearch
+// filter syntax, and MarshalJSON as a JSON string.
+type Expression interface {
+	fmt.Stringer
+	json.Marshaler
+	// precedence of the expression, used to parenthesize it in an enclosing expression
+	precedence() int
+}
+
+const (
+	precedenceOr = iota
+	precedenceAnd
+	precedenceNot
+	precedenceCondition
+)
+
+// Operator is a comparison operator
+type Operator string
+
+const (
+	OperatorEqual          Operator = "="
+	OperatorNotEqual       Operator = "!="
+	OperatorGreater        Operator = ">"
+	OperatorGreaterOrEqual Operator = ">="
+	OperatorLower          Operator = "<"
+	OperatorLowerOrEqual   Operator = "<="
+)
+
+// Comparison is an `attribute <operator> value` condition
+type Comparison struct {
+	Attribute string
+	Operator  Operator
+	Value     interface{}
+}
+
+// Range is an `attribute from TO to` condition
+type Range struct {
+	Attribute string
+	From      interface{}
+	To        interface{}
+}
+
+// Membership is an `attribute IN [values]` condition, `attribute NOT IN [values]` when negated
+type Membership struct {
+	Attribute string
+	Values    []interface{}
+	Negated   bool
+}
+
+// Existence is an `attribute EXISTS` condition, `attribute NOT EXISTS` when negated
+type Existence struct {
+	Attribute string
+	Negated   bool
+}
+
+// Nullity is an `attribute IS NULL` condition, `attribute IS NOT NULL` when negated
+type Nullity struct {
+	Attribute string
+	Negated   bool
+}
+
+// Emptiness is an `attribute IS EMPTY` condition, `attribute IS NOT EMPTY` when negated
+type Emptiness struct {
+	Attribute string
+	Negated   bool
+}
+
+// GeoPoint is a position given by its latitude and longitude
+type GeoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+// GeoRadiusCondition matches the documents whose _geo position is within
+// Distance meters of Center
+type GeoRadiusCondition struct {
+	Center   GeoPoint
+	Distance float64
+}
+
+// GeoBoundingBoxCondition matches the documents whose _geo position is
+// within the rectangle going from TopRight to BottomLeft
+type GeoBoundingBoxCondition struct {
+	TopRight   GeoPoint
+	BottomLeft GeoPoint
+}
+
+// Conjunction is the AND of its expressions
+type Conjunction struct {
+	Expressions []Expression
+}
+
+// Disjunction is the OR of its expressions
+type Disjunction struct {
+	Expressions []Expression
+}
+
+// Negation is the NOT of its expression
+type Negation struct {
+	Expression Expression
+}
+
+// Eq returns an `attribute = value` condition
+func Eq(attribute string, value interface{}) Comparison {
+	return Comparison{Attribute: attribute, Operator: OperatorEqual, Value: value}
+}
+
+// Neq returns an `attribute != value` condition
+func Neq(attribute string, value interface{}) Comparison {
+	return Comparison{Attribute: attribute, Operator: OperatorNotEqual, Value: value}
+}
+
+// Gt returns an `attribute > value` condition
+func Gt(attribute string, value interface{}) Comparison {
+	return Comparison{Attribute: attribute, Operator: OperatorGreater, Value: value}
+}
+
+// Gte returns an `attribute >= value` condition
+func Gte(attribute string, value interface{}) Comparison {
+	return Comparison{Attribute: attribute, Operator: OperatorGreaterOrEqual, Value: value}
+}
+
+// Lt returns an `attribute < value` condition
+func Lt(attribute string, value interface{}) Comparison {
+	return Comparison{Attribute: attribute, Operator: OperatorLower, Value: value}
+}
+
+// Lte returns an `attribute <= value` condition
+func Lte(attribute string, value interface{}) Comparison {
+	return Comparison{Attribute: attribute, Operator: OperatorLowerOrEqual, Value: value}
+}
+
+// Between returns an `attribute from TO to` condition, bounds included
+func Between(attribute string, from, to interface{}) Range {
+	return Range{Attribute: attribute, From: from, To: to}
+}
+
+// In returns an `attribute IN [values]` condition
+func In(attribute string, values ...interface{}) Membership {
+	return Membership{Attribute: attribute, Values: values}
+}
+
+// NotIn returns an `attribute NOT IN [values]` condition
+func NotIn(attribute string, values ...interface{}) Membership {
+	return Membership{Attribute: attribute, Values: values, Negated: true}
+}
+
+// Exists returns an `attribute EXISTS` condition
+func Exists(attribute string) Existence {
+	return Existence{Attribute: attribute}
+}
+
+// NotExists returns an `attribute NOT EXISTS` condition
+func NotExists(attribute string) Existence {
+	return Existence{Attribute: attribute, Negated: true}
+}
+
+// IsNull returns an `attribute IS NULL` condition
+func IsNull(attribute string) Nullity {
+	return Nullity{Attribute: attribute}
+}
+
+// IsNotNull returns an `attribute IS NOT NULL` condition
+func IsNotNull(attribute string) Nullity {
+	return Nullity{Attribute: attribute, Negated: true}
+}
+
+// IsEmpty returns an `attribute IS EMPTY` condition
+func IsEmpty(attribute string) Emptiness {
+	return Emptiness{Attribute: attribute}
+}
+
+// IsNotEmpty returns an `attribute IS NOT EMPTY` condition
+func IsNotEmpty(attribute string) Emptiness {
+	return Emptiness{Attribute: attribute, Negated: true}
+}
+
+// GeoRadius returns a `_geoRadius(lat, lng, distance)` condition, distance being in meters
+func GeoRadius(lat, lng, distance float64) GeoRadiusCondition {
+	return GeoRadiusCondition{Center: GeoPoint{Lat: lat, Lng: lng}, Distance: distance}
+}
+
+// GeoBoundingBox returns a `_geoBoundingBox([lat, lng], [lat, lng])` condition
+func GeoBoundingBox(topRight, bottomLeft GeoPoint) GeoBoundingBoxCondition {
+	return GeoBoundingBoxCondition{TopRight: topRight, BottomLeft: bottomLeft}
+}
+
+// And returns the conjunction of the expressions, nil expressions are skipped
+func And(expressions ...Expression) Conjunction {
+	return Conjunction{Expressions: withoutNil(expressions)}
+}
+
+// Or returns the disjunction of the expressions, nil expressions are skipped
+func Or(expressions ...Expression) Disjunction {
+	return Disjunction{Expressions: withoutNil(expressions)}
+}
+
+// Not returns the negation of the expression
+func Not(expression Expression) Negation {
+	return Negation{Expression: expression}
+}
+
+func (c Comparison) String() string {
+	return Attribute(c.Attribute) + " " + string(c.Operator) + " " + Value(c.Value)
+}
+
+func (r Range) String() string {
+	return Attribute(r.Attribute) + " " + Value(r.From) + " TO " + Value(r.To)
+}
+
+func (m Membership) String() string {
+	values := make([]string, len(m.Values))
+	for i, value := range m.Values {
+		values[i] = Value(value)
+	}
+	operator := " IN "
+	if m.Negated {
+		operator = " NOT IN "
+	}
+	return Attribute(m.Attribute) + operator + "[" + strings.Join(values, ", ") + "]"
+}
+
+func (e Existence) String() string {
+	if e.Negated {
+		return Attribute(e.Attribute) + " NOT EXISTS"
+	}
+	return Attribute(e.Attribute) + " EXISTS"
+}
+
+func (n Nullity) String() string {
+	if n.Negated {
+		return Attribute(n.Attribute) + " IS NOT NULL"
+	}
+	return Attribute(n.Attribute) + " IS NULL"
+}
+
+func (e Emptiness) String() string {
+	if e.Negated {
+		return Attribute(e.Attribute) + " IS NOT EMPTY"
+	}
+	return Attribute(e.Attribute) + " IS EMPTY"
+}
+
+func (g GeoRadiusCondition) String() string {
+	return "_geoRadius(" + formatFloat(g.Center.Lat) + ", " + formatFloat(g.Center.Lng) + ", " + formatFloat(g.Distance) + ")"
+}
+
+func (g GeoBoundingBoxCondition) String() string {
+	return "_geoBoundingBox(" + g.TopRight.String() + ", " + g.BottomLeft.String() + ")"
+}
+
+func (p GeoPoint) String() string {
+	return "[" + formatFloat(p.Lat) + ", " + formatFloat(p.Lng) + "]"
+}
+
+func (c Conjunction) String() string {
+	return join(c.Expressions, " AND ", precedenceAnd)
+}
+
+func (d Disjunction) String() string {
+	return join(d.Expressions, " OR ", precedenceOr)
+}
+
+func (n Negation) String() string {
+	if n.Expression == nil {
+		return ""
+	}
+	// An empty operand would leave a dangling NOT
+	inner := operand(n.Expression, precedenceNot)
+	if inner == "" {
+		return ""
+	}
+	return "NOT " + inner
+}
+
+func (c Comparison) MarshalJSON() ([]byte, error)              { return marshal(c) }
+func (r Range) MarshalJSON() ([]byte, error)                   { return marshal(r) }
+func (m Membership) MarshalJSON() ([]byte, error)              { return marshal(m) }
+func (e Existence) MarshalJSON() ([]byte, error)               { return marshal(e) }
+func (n Nullity) MarshalJSON() ([]byte, error)                 { return marshal(n) }
+func (e Emptiness) MarshalJSON() ([]byte, error)               { return marshal(e) }
+func (g GeoRadiusCondition) MarshalJSON() ([]byte, error)      { return marshal(g) }
+func (g GeoBoundingBoxCondition) MarshalJSON() ([]byte, error) { return marshal(g) }
+func (c Conjunction) MarshalJSON() ([]byte, error)             { return marshal(c) }
+func (d Disjunction) MarshalJSON() ([]byte, error)             { return marshal(d) }
+func (n Negation) MarshalJSON() ([]byte, error)                { return marshal(n) }
+
+// QuoteError is returned by MarshalJSON for an attribute or a value that cannot be quoted
+// in a filter, since it ends with a backslash or contains a backslash followed by a double quote
+type QuoteError struct {
+	Text string
+}
+
+func (e *QuoteError) Error() string {
+	return fmt.Sprintf("filter: %q cannot be quoted, it ends with a backslash or contains a backslash followed by a double quote", e.Text)
+}
+
+// marshal returns the expression as a JSON string, failing instead of sending a filter that
+// Meilisearch would read differently
+func marshal(expression Expression) ([]byte, error) {
+	if err := checkQuotable(expression); err != nil {
+		return nil, err
+	}
+	return json.Marshal(expression.String())
+}
+
+// checkQuotable returns a *QuoteError for the first attribute or value of the expression
+// that is quoted but not quotable
+func checkQuotable(expression Expression) error {
+	switch e := expression.(type) {
+	case Comparison:
+		return checkQuotableTexts(e.Attribute, e.Value)
+	case Range:
+		return checkQuotableTexts(e.Attribute, e.From, e.To)
+	case Membership:
+		return checkQuotableTexts(e.Attribute, e.Values...)
+	case Existence:
+		return checkQuotableTexts(e.Attribute)
+	case Nullity:
+		return checkQuotableTexts(e.Attribute)
+	case Emptiness:
+		return checkQuotableTexts(e.Attribute)
+	case Conjunction:
+		return checkQuotableAll(e.Expressions)
+	case Disjunction:
+		return checkQuotableAll(e.Expressions)
+	case Negation:
+		return checkQuotable(e.Expression)
+	}
+	return nil
+}
+
+func checkQuotableAll(expressions []Expression) error {
+	for _, expression := range expressions {
+		if err := checkQuotable(expression); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkQuotableTexts(attribute string, values ...interface{}) error {
+	if Attribute(attribute) != attribute && !quotable(attribute) {
+		return &QuoteError{Text: attribute}
+	}
+	for _, value := range values {
+		if strings.HasPrefix(Value(value), `"`) && !quotable(text(value)) {
+			return &QuoteError{Text: text(value)}
+		}
+	}
+	return nil
+}
+
+func (Comparison) precedence() int              { return precedenceCondition }
+func (Range) precedence() int                   { return precedenceCondition }
+func (Membership) precedence() int              { return precedenceCondition }
+func (Existence) precedence() int               { return precedenceCondition }
+func (Nullity) precedence() int                 { return precedenceCondition }
+func (Emptiness) precedence() int               { return precedenceCondition }
+func (GeoRadiusCondition) precedence() int      { return precedenceCondition }
+func (GeoBoundingBoxCondition) precedence() int { return precedenceCondition }
+func (Conjunction) precedence() int             { return precedenceAnd }
+func (Disjunction) precedence() int             { return precedenceOr }
+func (Negation) precedence() int                { return precedenceNot }
+
+// Attribute returns the attribute name as written in a filter, quoted when it
+// is not a plain name or could be read as a keyword
+func Attribute(name string) string {
+	if name == "" || isKeyword(name) {
+		return quote(name)
+	}
+	for _, r := range name {
+		if !isPlainRune(r) {
+			return quote(name)
+		}
+	}
+	return name
+}
+
+// Value returns the value as written in a filter. Numbers and booleans are
+// written as is, and any other value is written as a quoted string.
+func Value(value interface{}) string {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.FormatInt(int64(v), 10)
+	case int8:
+		return strconv.FormatInt(int64(v), 10)
+	case int16:
+		return strconv.FormatInt(int64(v), 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return formatFloat(v)
+	case json.Number:
+		return v.String()
+	default:
+		return quote(text(value))
+	}
+}
+
+// text returns the string written, quoted, for a value that is not a number or a boolean
+func text(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// quote wraps s in double quotes, escaping the double quotes it contains. Meilisearch only
+// unescapes the quote character, so the backslashes are kept as they are, and a string that
+// is not quotable cannot be expressed.
+func quote(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// quotable reports whether quote gives back s once read by Meilisearch, which is not the case
+// when s ends with a backslash or contains a backslash followed by a double quote
+func quotable(s string) bool {
+	return !strings.HasSuffix(s, `\`) && !strings.Contains(s, `\"`)
+}
+
+func isPlainRune(r rune) bool {
+	return r == '_' || r == '-' || r == '.' ||
+		(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+var keywords = []string{"AND", "OR", "NOT", "TO", "IN", "EXISTS", "IS", "NULL", "EMPTY"}
+
+func isKeyword(name string) bool {
+	for _, keyword := range keywords {
+		if strings.EqualFold(name, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutNil(expressions []Expression) []Expression {
+	filtered := make([]Expression, 0, len(expressions))
+	for _, expression := range expressions {
+		if expression != nil {
+			filtered = append(filtered, expression)
+		}
+	}
+	return filtered
+}
+
+// join renders the expressions separated by sep, skipping the empty ones
+func join(expressions []Expression, sep string, precedence int) string {
+	parts := make([]string, 0, len(expressions))
+	for _, expression := range expressions {
+		if part := operand(expression, precedence); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// operand renders the expression, parenthesized when it binds less tightly
+// than the operator it is an operand of
+func operand(expression Expression, precedence int) string {
+	s := expression.String()
+	if s == "" || expression.precedence() >= precedence {
+		return s
+	}
+	return "(" + s + ")"
+}
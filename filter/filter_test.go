@@ -0,0 +1,200 @@
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stringer struct{}
+
+func (stringer) String() string { return "from stringer" }
+
+func TestExpression_String(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression Expression
+		want       string
+	}{
+		{
+			name:       "TestFilterEqString",
+			expression: Eq("genre", "horror"),
+			want:       `genre = "horror"`,
+		},
+		{
+			name:       "TestFilterEqInt",
+			expression: Eq("year", 2005),
+			want:       `year = 2005`,
+		},
+		{
+			name:       "TestFilterEqBool",
+			expression: Eq("available", true),
+			want:       `available = true`,
+		},
+		{
+			name:       "TestFilterEqEscapesQuotes",
+			expression: Eq("title", `Kung Fu Panda "2"`),
+			want:       `title = "Kung Fu Panda \"2\""`,
+		},
+		{
+			name:       "TestFilterEqKeepsBackslashes",
+			expression: Eq("path", `C:\dir \o/`),
+			want:       `path = "C:\dir \o/"`,
+		},
+		{
+			name:       "TestFilterEqStringer",
+			expression: Eq("title", stringer{}),
+			want:       `title = "from stringer"`,
+		},
+		{
+			name:       "TestFilterNeqWithQuotedAttribute",
+			expression: Neq("release date", "unknown"),
+			want:       `"release date" != "unknown"`,
+		},
+		{
+			name:       "TestFilterGtWithKeywordAttribute",
+			expression: Gt("to", 1.5),
+			want:       `"to" > 1.5`,
+		},
+		{
+			name:       "TestFilterComparisons",
+			expression: And(Gte("rating", 3), Lt("price", uint(10)), Lte("stock", int64(-1))),
+			want:       `rating >= 3 AND price < 10 AND stock <= -1`,
+		},
+		{
+			name:       "TestFilterBetween",
+			expression: Between("year", 1970, 1979),
+			want:       `year 1970 TO 1979`,
+		},
+		{
+			name:       "TestFilterIn",
+			expression: In("genre", "rock", "n'roll", 3),
+			want:       `genre IN ["rock", "n'roll", 3]`,
+		},
+		{
+			name:       "TestFilterNotIn",
+			expression: NotIn("genre", "jazz"),
+			want:       `genre NOT IN ["jazz"]`,
+		},
+		{
+			name:       "TestFilterInWithoutValues",
+			expression: In("genre"),
+			want:       `genre IN []`,
+		},
+		{
+			name:       "TestFilterExistsNullEmpty",
+			expression: And(Exists("a"), NotExists("b"), IsNull("c"), IsNotNull("d"), IsEmpty("e"), IsNotEmpty("f")),
+			want:       `a EXISTS AND b NOT EXISTS AND c IS NULL AND d IS NOT NULL AND e IS EMPTY AND f IS NOT EMPTY`,
+		},
+		{
+			name:       "TestFilterGeoRadius",
+			expression: GeoRadius(48.8566, 2.3522, 2000),
+			want:       `_geoRadius(48.8566, 2.3522, 2000)`,
+		},
+		{
+			name:       "TestFilterGeoBoundingBox",
+			expression: GeoBoundingBox(GeoPoint{Lat: 45.494181, Lng: 9.214024}, GeoPoint{Lat: 45.449484, Lng: 9.179175}),
+			want:       `_geoBoundingBox([45.494181, 9.214024], [45.449484, 9.179175])`,
+		},
+		{
+			name:       "TestFilterOrInsideAnd",
+			expression: And(Eq("a", 1), Or(Eq("b", 2), Eq("c", 3))),
+			want:       `a = 1 AND (b = 2 OR c = 3)`,
+		},
+		{
+			name:       "TestFilterAndInsideOr",
+			expression: Or(And(Eq("a", 1), Eq("b", 2)), Eq("c", 3)),
+			want:       `a = 1 AND b = 2 OR c = 3`,
+		},
+		{
+			name:       "TestFilterNotCondition",
+			expression: Not(Eq("a", 1)),
+			want:       `NOT a = 1`,
+		},
+		{
+			name:       "TestFilterNotConjunction",
+			expression: Not(And(Eq("a", 1), Eq("b", 2))),
+			want:       `NOT (a = 1 AND b = 2)`,
+		},
+		{
+			name:       "TestFilterNotInsideAnd",
+			expression: And(Not(Eq("a", 1)), Not(Not(Exists("b")))),
+			want:       `NOT a = 1 AND NOT NOT b EXISTS`,
+		},
+		{
+			name:       "TestFilterSkipsNilAndEmptyExpressions",
+			expression: And(nil, Or(), Eq("a", 1), Not(nil)),
+			want:       `a = 1`,
+		},
+		{
+			name:       "TestFilterEmptyAnd",
+			expression: And(),
+			want:       ``,
+		},
+		{
+			name:       "TestFilterNotEmptyAnd",
+			expression: Not(And()),
+			want:       ``,
+		},
+		{
+			name:       "TestFilterNotEmptyOrInsideAnd",
+			expression: And(Eq("a", 1), Not(Or())),
+			want:       `a = 1`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.expression.String())
+
+			got, err := json.Marshal(tt.expression)
+			require.NoError(t, err)
+			want, _ := json.Marshal(tt.want)
+			require.JSONEq(t, string(want), string(got))
+		})
+	}
+}
+
+func TestExpression_MarshalJSONInRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"filter": []interface{}{Eq("genre", `say "hi"`), []Expression{Eq("a", 1), Eq("b", 2)}},
+	}
+	got, err := json.Marshal(request)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"filter":["genre = \"say \\\"hi\\\"\"",["a = 1","b = 2"]]}`, string(got))
+}
+
+func TestExpression_MarshalJSONWithUnquotableText(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression Expression
+		wantText   string
+	}{
+		{
+			name:       "TestMarshalValueEndingWithBackslash",
+			expression: Eq("a", `x\`),
+			wantText:   `x\`,
+		},
+		{
+			name:       "TestMarshalValueWithEscapedQuote",
+			expression: Or(Eq("a", 1), Not(In("b", "ok", `say \"hi\"`))),
+			wantText:   `say \"hi\"`,
+		},
+		{
+			name:       "TestMarshalAttributeEndingWithBackslash",
+			expression: And(Exists(`dir\`)),
+			wantText:   `dir\`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := json.Marshal(tt.expression)
+			var quoteErr *QuoteError
+			require.ErrorAs(t, err, &quoteErr)
+			require.Equal(t, tt.wantText, quoteErr.Text)
+		})
+	}
+
+	_, err := json.Marshal(Eq("path", `C:\dir`))
+	require.NoError(t, err)
+}
@@ -0,0 +1,582 @@
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SyntaxError is returned when a filter can not be parsed.
+// Position is the byte offset in the filter where the error was found.
+type SyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("filter: %s at position %d", e.Message, e.Position)
+}
+
+// Parse parses a filter written in the Meilisearch filter syntax into an Expression.
+// Keywords (AND, OR, NOT, TO, IN, EXISTS, IS, NULL, EMPTY) must be written in uppercase.
+//
+// Unquoted numeric values are parsed as json.Number and any other value as a string,
+// so the String method of the returned Expression gives back an equivalent filter.
+func Parse(s string) (Expression, error) {
+	p := &parser{input: s}
+	return p.parse()
+}
+
+// ParseAndValidate parses the filter like Parse, then reports an *AttributeError
+// positioned on the first attribute of the filter that is not filterable.
+func ParseAndValidate(s string, filterableAttributes []string) (Expression, error) {
+	p := &parser{input: s, filterableAttributes: filterableAttributes, validate: true}
+	return p.parse()
+}
+
+// MaxDepth is the maximum number of nested parentheses and NOT accepted in a filter,
+// the same limit as Meilisearch. Deeper filters are rejected with a *SyntaxError.
+const MaxDepth = 200
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBracket
+	tokenRightBracket
+	tokenComma
+	tokenOperator
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	position int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of filter"
+	case tokenWord:
+		return fmt.Sprintf("%q", t.text)
+	case tokenString:
+		return "quoted value " + strconv.Quote(t.text)
+	default:
+		return "`" + t.text + "`"
+	}
+}
+
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenWord && t.text == keyword
+}
+
+// isValue reports whether the token can be used as an attribute or a value
+func (t token) isValue() bool {
+	return t.kind == tokenString || (t.kind == tokenWord && !isReservedWord(t.text))
+}
+
+func isReservedWord(word string) bool {
+	for _, keyword := range keywords {
+		if word == keyword {
+			return true
+		}
+	}
+	return false
+}
+
+func isSyntaxRune(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`()[],=!<>'"`, r)
+}
+
+var punctuation = map[byte]tokenKind{
+	'(': tokenLeftParen,
+	')': tokenRightParen,
+	'[': tokenLeftBracket,
+	']': tokenRightBracket,
+	',': tokenComma,
+}
+
+type parser struct {
+	input    string
+	offset   int
+	current  token
+	peeked   bool
+	validate bool
+	depth    int
+
+	filterableAttributes []string
+}
+
+func (p *parser) parse() (Expression, error) {
+	expression, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t, err := p.peek(); err != nil {
+		return nil, err
+	} else if t.kind != tokenEOF {
+		return nil, p.unexpected(t, "AND, OR or end of filter")
+	}
+	return expression, nil
+}
+
+// peek returns the next token without consuming it
+func (p *parser) peek() (token, error) {
+	if p.peeked {
+		return p.current, nil
+	}
+	t, err := p.lex()
+	if err != nil {
+		return token{}, err
+	}
+	p.current = t
+	p.peeked = true
+	return t, nil
+}
+
+// next consumes the next token
+func (p *parser) next() (token, error) {
+	t, err := p.peek()
+	p.peeked = false
+	return t, err
+}
+
+// skip consumes the token returned by the last call to peek
+func (p *parser) skip() {
+	p.peeked = false
+}
+
+func (p *parser) lex() (token, error) {
+	for p.offset < len(p.input) {
+		r, size := utf8.DecodeRuneInString(p.input[p.offset:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		p.offset += size
+	}
+	start := p.offset
+	if start >= len(p.input) {
+		return token{kind: tokenEOF, position: start}, nil
+	}
+
+	c := p.input[start]
+	if kind, ok := punctuation[c]; ok {
+		p.offset++
+		return token{kind: kind, text: string(c), position: start}, nil
+	}
+	switch c {
+	case '=':
+		p.offset++
+		return token{kind: tokenOperator, text: "=", position: start}, nil
+	case '!', '<', '>':
+		p.offset++
+		if p.offset < len(p.input) && p.input[p.offset] == '=' {
+			p.offset++
+			return token{kind: tokenOperator, text: p.input[start:p.offset], position: start}, nil
+		}
+		if c == '!' {
+			return token{}, &SyntaxError{Position: start, Message: "expected `!=`"}
+		}
+		return token{kind: tokenOperator, text: string(c), position: start}, nil
+	case '"', '\'':
+		return p.lexString(c)
+	}
+
+	for p.offset < len(p.input) {
+		r, size := utf8.DecodeRuneInString(p.input[p.offset:])
+		if isSyntaxRune(r) {
+			break
+		}
+		p.offset += size
+	}
+	return token{kind: tokenWord, text: p.input[start:p.offset], position: start}, nil
+}
+
+// lexString reads a value quoted by quote. Like Meilisearch, a backslash prevents the character
+// following it from ending the value, but only an escaped quote is unescaped: "a\\b" is a\\b.
+func (p *parser) lexString(quote byte) (token, error) {
+	start := p.offset
+	p.offset++
+	var b strings.Builder
+	for p.offset < len(p.input) {
+		c := p.input[p.offset]
+		switch {
+		case c == quote:
+			p.offset++
+			return token{kind: tokenString, text: b.String(), position: start}, nil
+		case c == '\\' && p.offset+1 < len(p.input):
+			if p.input[p.offset+1] != quote {
+				b.WriteByte(c)
+			}
+			b.WriteByte(p.input[p.offset+1])
+			p.offset += 2
+		default:
+			b.WriteByte(c)
+			p.offset++
+		}
+	}
+	return token{}, &SyntaxError{Position: start, Message: "unterminated quoted value"}
+}
+
+func (p *parser) unexpected(t token, expected string) error {
+	return &SyntaxError{Position: t.position, Message: "expected " + expected + ", found " + t.String()}
+}
+
+// expect consumes the next token, failing if it is not of the given kind
+func (p *parser) expect(kind tokenKind, expected string) (token, error) {
+	t, err := p.next()
+	if err != nil {
+		return token{}, err
+	}
+	if t.kind != kind {
+		return token{}, p.unexpected(t, expected)
+	}
+	return t, nil
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if !t.isKeyword(keyword) {
+		return p.unexpected(t, keyword)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (Expression, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	expressions := []Expression{first}
+	for {
+		t, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		if !t.isKeyword("OR") {
+			break
+		}
+		p.skip()
+		expression, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, expression)
+	}
+	if len(expressions) == 1 {
+		return first, nil
+	}
+	return Disjunction{Expressions: expressions}, nil
+}
+
+func (p *parser) parseAnd() (Expression, error) {
+	first, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	expressions := []Expression{first}
+	for {
+		t, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		if !t.isKeyword("AND") {
+			break
+		}
+		p.skip()
+		expression, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, expression)
+	}
+	if len(expressions) == 1 {
+		return first, nil
+	}
+	return Conjunction{Expressions: expressions}, nil
+}
+
+func (p *parser) parseNot() (Expression, error) {
+	t, err := p.peek()
+	if err != nil {
+		return nil, err
+	}
+	if t.isKeyword("NOT") {
+		p.skip()
+		if err := p.enter(t); err != nil {
+			return nil, err
+		}
+		expression, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		p.depth--
+		return Negation{Expression: expression}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expression, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if t.kind == tokenLeftParen {
+		if err := p.enter(t); err != nil {
+			return nil, err
+		}
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, "`)`"); err != nil {
+			return nil, err
+		}
+		p.depth--
+		return expression, nil
+	}
+	if t.kind == tokenWord && strings.HasPrefix(t.text, "_geo") {
+		if next, err := p.peek(); err != nil {
+			return nil, err
+		} else if next.kind == tokenLeftParen {
+			return p.parseGeo(t)
+		}
+	}
+	if !t.isValue() {
+		return nil, p.unexpected(t, "an attribute, `(` or NOT")
+	}
+	return p.parseCondition(t)
+}
+
+// enter nests the parser one level deeper for the `(` or NOT read in the token t
+func (p *parser) enter(t token) error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return &SyntaxError{Position: t.position, Message: fmt.Sprintf("filter nested deeper than %d levels", MaxDepth)}
+	}
+	return nil
+}
+
+// parseCondition parses the condition on the attribute read in the token t
+func (p *parser) parseCondition(t token) (Expression, error) {
+	attribute := t.text
+	if err := p.checkAttribute(attribute, t.position); err != nil {
+		return nil, err
+	}
+
+	next, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case next.kind == tokenOperator:
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return Comparison{Attribute: attribute, Operator: Operator(next.text), Value: value}, nil
+	case next.isKeyword("IN"):
+		values, err := p.parseValues()
+		if err != nil {
+			return nil, err
+		}
+		return Membership{Attribute: attribute, Values: values}, nil
+	case next.isKeyword("EXISTS"):
+		return Existence{Attribute: attribute}, nil
+	case next.isKeyword("NOT"):
+		keyword, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case keyword.isKeyword("IN"):
+			values, err := p.parseValues()
+			if err != nil {
+				return nil, err
+			}
+			return Membership{Attribute: attribute, Values: values, Negated: true}, nil
+		case keyword.isKeyword("EXISTS"):
+			return Existence{Attribute: attribute, Negated: true}, nil
+		}
+		return nil, p.unexpected(keyword, "IN or EXISTS")
+	case next.isKeyword("IS"):
+		keyword, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		negated := keyword.isKeyword("NOT")
+		if negated {
+			if keyword, err = p.next(); err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case keyword.isKeyword("NULL"):
+			return Nullity{Attribute: attribute, Negated: negated}, nil
+		case keyword.isKeyword("EMPTY"):
+			return Emptiness{Attribute: attribute, Negated: negated}, nil
+		}
+		if negated {
+			return nil, p.unexpected(keyword, "NULL or EMPTY")
+		}
+		return nil, p.unexpected(keyword, "NOT, NULL or EMPTY")
+	case next.isValue():
+		from := valueOf(next)
+		if err := p.expectKeyword("TO"); err != nil {
+			return nil, err
+		}
+		to, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return Range{Attribute: attribute, From: from, To: to}, nil
+	}
+	return nil, p.unexpected(next, "an operator, IN, NOT, EXISTS, IS or a range")
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	t, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if !t.isValue() {
+		return nil, p.unexpected(t, "a value")
+	}
+	return valueOf(t), nil
+}
+
+// parseValues parses a `[value, ...]` list, a trailing comma being allowed
+func (p *parser) parseValues() ([]interface{}, error) {
+	if _, err := p.expect(tokenLeftBracket, "`[`"); err != nil {
+		return nil, err
+	}
+	var values []interface{}
+	for {
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind == tokenRightBracket {
+			return values, nil
+		}
+		if !t.isValue() {
+			return nil, p.unexpected(t, "a value or `]`")
+		}
+		values = append(values, valueOf(t))
+
+		t, err = p.next()
+		if err != nil {
+			return nil, err
+		}
+		if t.kind == tokenRightBracket {
+			return values, nil
+		}
+		if t.kind != tokenComma {
+			return nil, p.unexpected(t, "`,` or `]`")
+		}
+	}
+}
+
+// parseGeo parses the _geoRadius or _geoBoundingBox function named by the token t
+func (p *parser) parseGeo(t token) (Expression, error) {
+	if err := p.checkAttribute("_geo", t.position); err != nil {
+		return nil, err
+	}
+	p.skip()
+	switch t.text {
+	case "_geoRadius":
+		numbers, err := p.parseNumbers(3, tokenRightParen, "`)`")
+		if err != nil {
+			return nil, err
+		}
+		return GeoRadius(numbers[0], numbers[1], numbers[2]), nil
+	case "_geoBoundingBox":
+		topRight, err := p.parsePoint()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenComma, "`,`"); err != nil {
+			return nil, err
+		}
+		bottomLeft, err := p.parsePoint()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, "`)`"); err != nil {
+			return nil, err
+		}
+		return GeoBoundingBox(topRight, bottomLeft), nil
+	}
+	return nil, &SyntaxError{Position: t.position, Message: "unknown geo function " + t.String() + ", expected _geoRadius or _geoBoundingBox"}
+}
+
+func (p *parser) parsePoint() (GeoPoint, error) {
+	if _, err := p.expect(tokenLeftBracket, "`[`"); err != nil {
+		return GeoPoint{}, err
+	}
+	numbers, err := p.parseNumbers(2, tokenRightBracket, "`]`")
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	return GeoPoint{Lat: numbers[0], Lng: numbers[1]}, nil
+}
+
+// parseNumbers parses count comma separated numbers followed by the closing token
+func (p *parser) parseNumbers(count int, closing tokenKind, expected string) ([]float64, error) {
+	numbers := make([]float64, 0, count)
+	for len(numbers) < count {
+		if len(numbers) > 0 {
+			if _, err := p.expect(tokenComma, "`,`"); err != nil {
+				return nil, err
+			}
+		}
+		t, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if (t.kind != tokenWord && t.kind != tokenString) || !isNumber(t.text) {
+			return nil, p.unexpected(t, "a number")
+		}
+		number, _ := strconv.ParseFloat(t.text, 64)
+		numbers = append(numbers, number)
+	}
+	if _, err := p.expect(closing, expected); err != nil {
+		return nil, err
+	}
+	return numbers, nil
+}
+
+func (p *parser) checkAttribute(attribute string, position int) error {
+	if !p.validate || isFilterable(attribute, p.filterableAttributes) {
+		return nil
+	}
+	return &AttributeError{Attribute: attribute, Position: position}
+}
+
+// valueOf returns the value of the token, a json.Number for unquoted numbers
+func valueOf(t token) interface{} {
+	if t.kind == tokenWord && isNumber(t.text) {
+		return json.Number(t.text)
+	}
+	return t.text
+}
+
+// isNumber reports whether s is a decimal number, leaving out the
+// infinities, NaN and hexadecimal numbers accepted by strconv.ParseFloat
+func isNumber(s string) bool {
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return false
+	}
+	return strings.Trim(s, "0123456789+-.eE") == ""
+}
@@ -0,0 +1,271 @@
+package filter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		want   Expression
+		// wantString is the filter given back by the String method of the expression
+		wantString string
+	}{
+		{
+			name:       "TestParseComparison",
+			filter:     "genre = horror",
+			want:       Eq("genre", "horror"),
+			wantString: `genre = "horror"`,
+		},
+		{
+			name:       "TestParseComparisonWithoutSpaces",
+			filter:     "year>=2005",
+			want:       Gte("year", json.Number("2005")),
+			wantString: `year >= 2005`,
+		},
+		{
+			name:       "TestParseComparisonOperators",
+			filter:     "a != 1 AND b > -1.5 AND c < 1e3 AND d <= x",
+			want:       And(Neq("a", json.Number("1")), Gt("b", json.Number("-1.5")), Lt("c", json.Number("1e3")), Lte("d", "x")),
+			wantString: `a != 1 AND b > -1.5 AND c < 1e3 AND d <= "x"`,
+		},
+		{
+			name:       "TestParseQuotedValues",
+			filter:     `"release date" = 'Kung Fu Panda "2"' OR title = "it\"s \\o/"`,
+			want:       Or(Eq("release date", `Kung Fu Panda "2"`), Eq("title", `it"s \\o/`)),
+			wantString: `"release date" = "Kung Fu Panda \"2\"" OR title = "it\"s \\o/"`,
+		},
+		{
+			name:       "TestParseBackslashes",
+			filter:     `path = "C:\dir" OR path = 'it\'s \o/'`,
+			want:       Or(Eq("path", `C:\dir`), Eq("path", `it's \o/`)),
+			wantString: `path = "C:\dir" OR path = "it's \o/"`,
+		},
+		{
+			name:       "TestParseQuotedNumberIsString",
+			filter:     `year = "2005"`,
+			want:       Eq("year", "2005"),
+			wantString: `year = "2005"`,
+		},
+		{
+			name:       "TestParseNonNumericWords",
+			filter:     "a = NaN AND b = inf AND c = 0x10",
+			want:       And(Eq("a", "NaN"), Eq("b", "inf"), Eq("c", "0x10")),
+			wantString: `a = "NaN" AND b = "inf" AND c = "0x10"`,
+		},
+		{
+			name:       "TestParseRange",
+			filter:     "year 1970 TO 1979",
+			want:       Between("year", json.Number("1970"), json.Number("1979")),
+			wantString: `year 1970 TO 1979`,
+		},
+		{
+			name:       "TestParseIn",
+			filter:     `genre IN [rock, "n'roll", 3,]`,
+			want:       In("genre", "rock", "n'roll", json.Number("3")),
+			wantString: `genre IN ["rock", "n'roll", 3]`,
+		},
+		{
+			name:       "TestParseNotIn",
+			filter:     "genre NOT IN []",
+			want:       NotIn("genre"),
+			wantString: `genre NOT IN []`,
+		},
+		{
+			name:       "TestParseExistsNullEmpty",
+			filter:     "a EXISTS AND b NOT EXISTS AND c IS NULL AND d IS NOT NULL AND e IS EMPTY AND f IS NOT EMPTY",
+			want:       And(Exists("a"), NotExists("b"), IsNull("c"), IsNotNull("d"), IsEmpty("e"), IsNotEmpty("f")),
+			wantString: `a EXISTS AND b NOT EXISTS AND c IS NULL AND d IS NOT NULL AND e IS EMPTY AND f IS NOT EMPTY`,
+		},
+		{
+			name:       "TestParseGeo",
+			filter:     "_geoRadius(48.8566, 2.3522, 2000) OR _geoBoundingBox([45.49, 9.21], [45.44, 9.17])",
+			want:       Or(GeoRadius(48.8566, 2.3522, 2000), GeoBoundingBox(GeoPoint{Lat: 45.49, Lng: 9.21}, GeoPoint{Lat: 45.44, Lng: 9.17})),
+			wantString: `_geoRadius(48.8566, 2.3522, 2000) OR _geoBoundingBox([45.49, 9.21], [45.44, 9.17])`,
+		},
+		{
+			name:       "TestParsePrecedence",
+			filter:     "a = 1 OR b = 2 AND NOT c = 3",
+			want:       Or(Eq("a", json.Number("1")), And(Eq("b", json.Number("2")), Not(Eq("c", json.Number("3"))))),
+			wantString: `a = 1 OR b = 2 AND NOT c = 3`,
+		},
+		{
+			name:       "TestParseParentheses",
+			filter:     "NOT (a = 1 OR (b = 2)) AND c EXISTS",
+			want:       And(Not(Or(Eq("a", json.Number("1")), Eq("b", json.Number("2")))), Exists("c")),
+			wantString: `NOT (a = 1 OR b = 2) AND c EXISTS`,
+		},
+		{
+			name:       "TestParseNestedAttribute",
+			filter:     "author.name = Tolkien",
+			want:       Eq("author.name", "Tolkien"),
+			wantString: `author.name = "Tolkien"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantString, got.String())
+
+			reparsed, err := Parse(got.String())
+			require.NoError(t, err)
+			require.Equal(t, got.String(), reparsed.String())
+		})
+	}
+}
+
+func TestParse_SyntaxError(t *testing.T) {
+	tests := []struct {
+		name         string
+		filter       string
+		wantPosition int
+		wantMessage  string
+	}{
+		{
+			name:         "TestParseEmptyFilter",
+			filter:       "",
+			wantPosition: 0,
+			wantMessage:  "expected an attribute, `(` or NOT, found end of filter",
+		},
+		{
+			name:         "TestParseMissingValue",
+			filter:       "genre = ",
+			wantPosition: 8,
+			wantMessage:  "expected a value, found end of filter",
+		},
+		{
+			name:         "TestParseMissingOperator",
+			filter:       "genre horror",
+			wantPosition: 12,
+			wantMessage:  "expected TO, found end of filter",
+		},
+		{
+			name:         "TestParseLowercaseKeyword",
+			filter:       "a = 1 and b = 2",
+			wantPosition: 6,
+			wantMessage:  `expected AND, OR or end of filter, found "and"`,
+		},
+		{
+			name:         "TestParseKeywordAsValue",
+			filter:       "a = AND",
+			wantPosition: 4,
+			wantMessage:  `expected a value, found "AND"`,
+		},
+		{
+			name:         "TestParseUnterminatedQuote",
+			filter:       `title = "Kung Fu`,
+			wantPosition: 8,
+			wantMessage:  "unterminated quoted value",
+		},
+		{
+			name:         "TestParseUnbalancedParenthesis",
+			filter:       "(a = 1 OR b = 2",
+			wantPosition: 15,
+			wantMessage:  "expected `)`, found end of filter",
+		},
+		{
+			name:         "TestParseExclamationMark",
+			filter:       "a ! 1",
+			wantPosition: 2,
+			wantMessage:  "expected `!=`",
+		},
+		{
+			name:         "TestParseInWithoutBrackets",
+			filter:       "genre IN rock",
+			wantPosition: 9,
+			wantMessage:  "expected `[`, found \"rock\"",
+		},
+		{
+			name:         "TestParseInWithoutComma",
+			filter:       "genre IN [rock jazz]",
+			wantPosition: 15,
+			wantMessage:  "expected `,` or `]`, found \"jazz\"",
+		},
+		{
+			name:         "TestParseIsWithoutNull",
+			filter:       "genre IS NOT 1",
+			wantPosition: 13,
+			wantMessage:  "expected NULL or EMPTY, found \"1\"",
+		},
+		{
+			name:         "TestParseGeoRadiusWithTooFewArguments",
+			filter:       "_geoRadius(1, 2)",
+			wantPosition: 15,
+			wantMessage:  "expected `,`, found `)`",
+		},
+		{
+			name:         "TestParseGeoRadiusWithInvalidNumber",
+			filter:       "_geoRadius(1, north, 3)",
+			wantPosition: 14,
+			wantMessage:  "expected a number, found \"north\"",
+		},
+		{
+			name:         "TestParseUnknownGeoFunction",
+			filter:       "_geoDistance(1, 2)",
+			wantPosition: 0,
+			wantMessage:  "unknown geo function \"_geoDistance\", expected _geoRadius or _geoBoundingBox",
+		},
+		{
+			name:         "TestParseTrailingOperator",
+			filter:       "a = 1 AND",
+			wantPosition: 9,
+			wantMessage:  "expected an attribute, `(` or NOT, found end of filter",
+		},
+		{
+			name:         "TestParseTooDeepParentheses",
+			filter:       strings.Repeat("(", MaxDepth+1) + "a = 1" + strings.Repeat(")", MaxDepth+1),
+			wantPosition: MaxDepth,
+			wantMessage:  "filter nested deeper than 200 levels",
+		},
+		{
+			name:         "TestParseTooDeepNegations",
+			filter:       "a = 1 OR " + strings.Repeat("NOT (", MaxDepth/2) + "NOT a = 1" + strings.Repeat(")", MaxDepth/2),
+			wantPosition: 9 + 5*MaxDepth/2,
+			wantMessage:  "filter nested deeper than 200 levels",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.filter)
+			require.Nil(t, got)
+			require.Error(t, err)
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			require.Equal(t, tt.wantPosition, syntaxErr.Position)
+			require.Equal(t, tt.wantMessage, syntaxErr.Message)
+		})
+	}
+}
+
+func TestParse_MaxDepth(t *testing.T) {
+	filter := strings.Repeat("NOT (", MaxDepth/2) + "a = 1" + strings.Repeat(")", MaxDepth/2)
+	got, err := Parse(filter)
+	require.NoError(t, err)
+	require.Equal(t, strings.Repeat("NOT ", MaxDepth/2)+"a = 1", got.String())
+}
+
+func TestParseAndValidate(t *testing.T) {
+	filterableAttributes := []string{"genre", "author", "_geo"}
+
+	got, err := ParseAndValidate("genre = horror AND author.name = King AND _geoRadius(1, 2, 3)", filterableAttributes)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	got, err = ParseAndValidate("genre = horror AND year > 2000", filterableAttributes)
+	require.Nil(t, got)
+	require.Equal(t, &AttributeError{Attribute: "year", Position: 19}, err)
+	require.EqualError(t, err, `filter: attribute "year" is not filterable at position 19`)
+
+	_, err = ParseAndValidate("_geoRadius(1, 2, 3)", []string{"genre"})
+	require.Equal(t, &AttributeError{Attribute: "_geo", Position: 0}, err)
+
+	_, err = ParseAndValidate("genre =", filterableAttributes)
+	require.IsType(t, &SyntaxError{}, err)
+}
@@ -0,0 +1,76 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AttributeError is returned when a filter uses an attribute that is not in the
+// filterable attributes of the index. Position is the byte offset of the attribute
+// in the parsed filter, -1 when the expression was not parsed.
+type AttributeError struct {
+	Attribute string
+	Position  int
+}
+
+func (e *AttributeError) Error() string {
+	if e.Position < 0 {
+		return fmt.Sprintf("filter: attribute %q is not filterable", e.Attribute)
+	}
+	return fmt.Sprintf("filter: attribute %q is not filterable at position %d", e.Attribute, e.Position)
+}
+
+// Validate reports an *AttributeError for the first attribute of the expression that is
+// not filterable. The geo conditions require _geo to be filterable.
+func Validate(expression Expression, filterableAttributes []string) error {
+	var attribute string
+	switch e := expression.(type) {
+	case nil:
+		return nil
+	case Comparison:
+		attribute = e.Attribute
+	case Range:
+		attribute = e.Attribute
+	case Membership:
+		attribute = e.Attribute
+	case Existence:
+		attribute = e.Attribute
+	case Nullity:
+		attribute = e.Attribute
+	case Emptiness:
+		attribute = e.Attribute
+	case GeoRadiusCondition, GeoBoundingBoxCondition:
+		attribute = "_geo"
+	case Conjunction:
+		return validateAll(e.Expressions, filterableAttributes)
+	case Disjunction:
+		return validateAll(e.Expressions, filterableAttributes)
+	case Negation:
+		return Validate(e.Expression, filterableAttributes)
+	default:
+		return fmt.Errorf("filter: unknown expression type %T", expression)
+	}
+	if !isFilterable(attribute, filterableAttributes) {
+		return &AttributeError{Attribute: attribute, Position: -1}
+	}
+	return nil
+}
+
+func validateAll(expressions []Expression, filterableAttributes []string) error {
+	for _, expression := range expressions {
+		if err := Validate(expression, filterableAttributes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isFilterable reports whether the attribute, or one of the objects it is nested in, is filterable
+func isFilterable(attribute string, filterableAttributes []string) bool {
+	for _, filterable := range filterableAttributes {
+		if attribute == filterable || strings.HasPrefix(attribute, filterable+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	filterableAttributes := []string{"genre", "year", "author", "_geo"}
+
+	tests := []struct {
+		name       string
+		expression Expression
+		wantErr    error
+	}{
+		{
+			name:       "TestValidateFilterableAttributes",
+			expression: And(Eq("genre", "horror"), Or(Between("year", 1970, 1979), Not(IsNull("year")))),
+		},
+		{
+			name:       "TestValidateNestedAttribute",
+			expression: In("author.name", "King", "Tolkien"),
+		},
+		{
+			name:       "TestValidateGeo",
+			expression: GeoBoundingBox(GeoPoint{Lat: 1, Lng: 2}, GeoPoint{Lat: 0, Lng: 0}),
+		},
+		{
+			name:       "TestValidateNil",
+			expression: nil,
+		},
+		{
+			name:       "TestValidateAttributeNotFilterable",
+			expression: And(Eq("genre", "horror"), Not(Exists("title"))),
+			wantErr:    &AttributeError{Attribute: "title", Position: -1},
+		},
+		{
+			name:       "TestValidateAttributeOnlySharingPrefix",
+			expression: Eq("authors", "King"),
+			wantErr:    &AttributeError{Attribute: "authors", Position: -1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.expression, filterableAttributes)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, tt.wantErr, err)
+			}
+		})
+	}
+
+	require.EqualError(t, Validate(Eq("title", 1), nil), `filter: attribute "title" is not filterable`)
+}
@@ -1,11 +1,20 @@
 module github.com/meilisearch/meilisearch-go
 
-go 1.16
+go 1.21
 
 require (
 	github.com/golang-jwt/jwt/v4 v4.4.3
-	github.com/klauspost/compress v1.15.6 // indirect
 	github.com/mailru/easyjson v0.7.7
 	github.com/stretchr/testify v1.8.1
 	github.com/valyala/fasthttp v1.37.1-0.20220607072126-8a320890c08d
 )
+
+require (
+	github.com/andybalholm/brotli v1.0.4 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/josharian/intern v1.0.0 // indirect
+	github.com/klauspost/compress v1.15.6 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -1,10 +1,12 @@
 package meilisearch
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // IndexConfig configure the Index
@@ -21,55 +23,163 @@ type IndexConfig struct {
 
 type IndexInterface interface {
 	FetchInfo() (resp *Index, err error)
+	FetchInfoWithContext(ctx context.Context) (resp *Index, err error)
 	FetchPrimaryKey() (resp *string, err error)
+	FetchPrimaryKeyWithContext(ctx context.Context) (resp *string, err error)
 	UpdateIndex(primaryKey string) (resp *TaskInfo, err error)
+	UpdateIndexWithContext(ctx context.Context, primaryKey string) (resp *TaskInfo, err error)
 	Delete(uid string) (ok bool, err error)
+	DeleteWithContext(ctx context.Context, uid string) (ok bool, err error)
 	GetStats() (resp *StatsIndex, err error)
+	GetStatsWithContext(ctx context.Context) (resp *StatsIndex, err error)
 
 	AddDocuments(documentsPtr interface{}, primaryKey ...string) (resp *TaskInfo, err error)
+	AddDocumentsWithContext(ctx context.Context, documentsPtr interface{}, primaryKey ...string) (resp *TaskInfo, err error)
 	AddDocumentsInBatches(documentsPtr interface{}, batchSize int, primaryKey ...string) (resp []TaskInfo, err error)
+	AddDocumentsInBatchesWithContext(ctx context.Context, documentsPtr interface{}, batchSize int, primaryKey ...string) (resp []TaskInfo, err error)
 	AddDocumentsCsv(documents []byte, primaryKey ...string) (resp *TaskInfo, err error)
+	AddDocumentsCsvWithContext(ctx context.Context, documents []byte, primaryKey ...string) (resp *TaskInfo, err error)
 	AddDocumentsCsvInBatches(documents []byte, batchSize int, primaryKey ...string) (resp []TaskInfo, err error)
+	AddDocumentsCsvInBatchesWithContext(ctx context.Context, documents []byte, batchSize int, primaryKey ...string) (resp []TaskInfo, err error)
 	AddDocumentsNdjson(documents []byte, primaryKey ...string) (resp *TaskInfo, err error)
+	AddDocumentsNdjsonWithContext(ctx context.Context, documents []byte, primaryKey ...string) (resp *TaskInfo, err error)
 	AddDocumentsNdjsonInBatches(documents []byte, batchSize int, primaryKey ...string) (resp []TaskInfo, err error)
+	AddDocumentsNdjsonInBatchesWithContext(ctx context.Context, documents []byte, batchSize int, primaryKey ...string) (resp []TaskInfo, err error)
 	UpdateDocuments(documentsPtr interface{}, primaryKey ...string) (resp *TaskInfo, err error)
+	UpdateDocumentsWithContext(ctx context.Context, documentsPtr interface{}, primaryKey ...string) (resp *TaskInfo, err error)
 	GetDocument(uid string, request *DocumentQuery, documentPtr interface{}) error
+	GetDocumentWithContext(ctx context.Context, uid string, request *DocumentQuery, documentPtr interface{}) error
 	GetDocuments(param *DocumentsQuery, resp *DocumentsResult) error
+	GetDocumentsWithContext(ctx context.Context, param *DocumentsQuery, resp *DocumentsResult) error
 	DeleteDocument(uid string) (resp *TaskInfo, err error)
+	DeleteDocumentWithContext(ctx context.Context, uid string) (resp *TaskInfo, err error)
 	DeleteDocuments(uid []string) (resp *TaskInfo, err error)
+	DeleteDocumentsWithContext(ctx context.Context, uid []string) (resp *TaskInfo, err error)
+	DeleteDocumentsByFilter(filter interface{}) (resp *TaskInfo, err error)
+	DeleteDocumentsByFilterWithContext(ctx context.Context, filter interface{}) (resp *TaskInfo, err error)
 	DeleteAllDocuments() (resp *TaskInfo, err error)
+	DeleteAllDocumentsWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	Search(query string, request *SearchRequest) (*SearchResponse, error)
+	SearchWithContext(ctx context.Context, query string, request *SearchRequest) (*SearchResponse, error)
 	SearchRaw(query string, request *SearchRequest) (*json.RawMessage, error)
+	SearchRawWithContext(ctx context.Context, query string, request *SearchRequest) (*json.RawMessage, error)
+	FacetSearch(facetName, facetQuery string, request *FacetSearchRequest) (*FacetSearchResponse, error)
+	FacetSearchWithContext(ctx context.Context, facetName, facetQuery string, request *FacetSearchRequest) (*FacetSearchResponse, error)
+	SearchSimilarDocuments(id string, query *SimilarDocumentsQuery) (*SimilarDocumentsResponse, error)
+	SearchSimilarDocumentsWithContext(ctx context.Context, id string, query *SimilarDocumentsQuery) (*SimilarDocumentsResponse, error)
+	ValidateFilter(expression string) error
+	ValidateFilterWithContext(ctx context.Context, expression string) error
 
 	GetTask(taskUID int64) (resp *Task, err error)
+	GetTaskWithContext(ctx context.Context, taskUID int64) (resp *Task, err error)
 	GetTasks(param *TasksQuery) (resp *TaskResult, err error)
+	GetTasksWithContext(ctx context.Context, param *TasksQuery) (resp *TaskResult, err error)
 
 	GetSettings() (resp *Settings, err error)
+	GetSettingsWithContext(ctx context.Context) (resp *Settings, err error)
 	UpdateSettings(request *Settings) (resp *TaskInfo, err error)
+	UpdateSettingsWithContext(ctx context.Context, request *Settings) (resp *TaskInfo, err error)
 	ResetSettings() (resp *TaskInfo, err error)
+	ResetSettingsWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	GetRankingRules() (resp *[]string, err error)
+	GetRankingRulesWithContext(ctx context.Context) (resp *[]string, err error)
 	UpdateRankingRules(request *[]string) (resp *TaskInfo, err error)
+	UpdateRankingRulesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error)
 	ResetRankingRules() (resp *TaskInfo, err error)
+	ResetRankingRulesWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	GetDistinctAttribute() (resp *string, err error)
+	GetDistinctAttributeWithContext(ctx context.Context) (resp *string, err error)
 	UpdateDistinctAttribute(request string) (resp *TaskInfo, err error)
+	UpdateDistinctAttributeWithContext(ctx context.Context, request string) (resp *TaskInfo, err error)
 	ResetDistinctAttribute() (resp *TaskInfo, err error)
+	ResetDistinctAttributeWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	GetSearchableAttributes() (resp *[]string, err error)
+	GetSearchableAttributesWithContext(ctx context.Context) (resp *[]string, err error)
 	UpdateSearchableAttributes(request *[]string) (resp *TaskInfo, err error)
+	UpdateSearchableAttributesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error)
 	ResetSearchableAttributes() (resp *TaskInfo, err error)
+	ResetSearchableAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	GetDisplayedAttributes() (resp *[]string, err error)
+	GetDisplayedAttributesWithContext(ctx context.Context) (resp *[]string, err error)
 	UpdateDisplayedAttributes(request *[]string) (resp *TaskInfo, err error)
+	UpdateDisplayedAttributesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error)
 	ResetDisplayedAttributes() (resp *TaskInfo, err error)
+	ResetDisplayedAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	GetStopWords() (resp *[]string, err error)
+	GetStopWordsWithContext(ctx context.Context) (resp *[]string, err error)
 	UpdateStopWords(request *[]string) (resp *TaskInfo, err error)
+	UpdateStopWordsWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error)
 	ResetStopWords() (resp *TaskInfo, err error)
+	ResetStopWordsWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	GetSynonyms() (resp *map[string][]string, err error)
+	GetSynonymsWithContext(ctx context.Context) (resp *map[string][]string, err error)
 	UpdateSynonyms(request *map[string][]string) (resp *TaskInfo, err error)
+	UpdateSynonymsWithContext(ctx context.Context, request *map[string][]string) (resp *TaskInfo, err error)
 	ResetSynonyms() (resp *TaskInfo, err error)
+	ResetSynonymsWithContext(ctx context.Context) (resp *TaskInfo, err error)
 	GetFilterableAttributes() (resp *[]string, err error)
+	GetFilterableAttributesWithContext(ctx context.Context) (resp *[]string, err error)
 	UpdateFilterableAttributes(request *[]string) (resp *TaskInfo, err error)
+	UpdateFilterableAttributesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error)
 	ResetFilterableAttributes() (resp *TaskInfo, err error)
+	ResetFilterableAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetEmbedders() (resp *map[string]Embedder, err error)
+	GetEmbeddersWithContext(ctx context.Context) (resp *map[string]Embedder, err error)
+	UpdateEmbedders(request *map[string]Embedder) (resp *TaskInfo, err error)
+	UpdateEmbeddersWithContext(ctx context.Context, request *map[string]Embedder) (resp *TaskInfo, err error)
+	ResetEmbedders() (resp *TaskInfo, err error)
+	ResetEmbeddersWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetProximityPrecision() (resp *ProximityPrecision, err error)
+	GetProximityPrecisionWithContext(ctx context.Context) (resp *ProximityPrecision, err error)
+	UpdateProximityPrecision(request ProximityPrecision) (resp *TaskInfo, err error)
+	UpdateProximityPrecisionWithContext(ctx context.Context, request ProximityPrecision) (resp *TaskInfo, err error)
+	ResetProximityPrecision() (resp *TaskInfo, err error)
+	ResetProximityPrecisionWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetSeparatorTokens() (resp *[]string, err error)
+	GetSeparatorTokensWithContext(ctx context.Context) (resp *[]string, err error)
+	UpdateSeparatorTokens(request *[]string) (resp *TaskInfo, err error)
+	UpdateSeparatorTokensWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error)
+	ResetSeparatorTokens() (resp *TaskInfo, err error)
+	ResetSeparatorTokensWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetNonSeparatorTokens() (resp *[]string, err error)
+	GetNonSeparatorTokensWithContext(ctx context.Context) (resp *[]string, err error)
+	UpdateNonSeparatorTokens(request *[]string) (resp *TaskInfo, err error)
+	UpdateNonSeparatorTokensWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error)
+	ResetNonSeparatorTokens() (resp *TaskInfo, err error)
+	ResetNonSeparatorTokensWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetDictionary() (resp *[]string, err error)
+	GetDictionaryWithContext(ctx context.Context) (resp *[]string, err error)
+	UpdateDictionary(request *[]string) (resp *TaskInfo, err error)
+	UpdateDictionaryWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error)
+	ResetDictionary() (resp *TaskInfo, err error)
+	ResetDictionaryWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetSearchCutoffMs() (resp *int64, err error)
+	GetSearchCutoffMsWithContext(ctx context.Context) (resp *int64, err error)
+	UpdateSearchCutoffMs(request int64) (resp *TaskInfo, err error)
+	UpdateSearchCutoffMsWithContext(ctx context.Context, request int64) (resp *TaskInfo, err error)
+	ResetSearchCutoffMs() (resp *TaskInfo, err error)
+	ResetSearchCutoffMsWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetLocalizedAttributes() (resp *[]LocalizedAttributes, err error)
+	GetLocalizedAttributesWithContext(ctx context.Context) (resp *[]LocalizedAttributes, err error)
+	UpdateLocalizedAttributes(request *[]LocalizedAttributes) (resp *TaskInfo, err error)
+	UpdateLocalizedAttributesWithContext(ctx context.Context, request *[]LocalizedAttributes) (resp *TaskInfo, err error)
+	ResetLocalizedAttributes() (resp *TaskInfo, err error)
+	ResetLocalizedAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetFacetSearch() (resp *bool, err error)
+	GetFacetSearchWithContext(ctx context.Context) (resp *bool, err error)
+	UpdateFacetSearch(request bool) (resp *TaskInfo, err error)
+	UpdateFacetSearchWithContext(ctx context.Context, request bool) (resp *TaskInfo, err error)
+	ResetFacetSearch() (resp *TaskInfo, err error)
+	ResetFacetSearchWithContext(ctx context.Context) (resp *TaskInfo, err error)
+	GetPrefixSearch() (resp *PrefixSearch, err error)
+	GetPrefixSearchWithContext(ctx context.Context) (resp *PrefixSearch, err error)
+	UpdatePrefixSearch(request PrefixSearch) (resp *TaskInfo, err error)
+	UpdatePrefixSearchWithContext(ctx context.Context, request PrefixSearch) (resp *TaskInfo, err error)
+	ResetPrefixSearch() (resp *TaskInfo, err error)
+	ResetPrefixSearchWithContext(ctx context.Context) (resp *TaskInfo, err error)
 
 	WaitForTask(taskUID int64, options ...WaitParams) (*Task, error)
+	WaitForTaskWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error)
 }
 
 var _ IndexInterface = &Index{}
@@ -82,6 +192,10 @@ func newIndex(client *Client, uid string) *Index {
 }
 
 func (i Index) FetchInfo() (resp *Index, err error) {
+	return i.FetchInfoWithContext(context.Background())
+}
+
+func (i Index) FetchInfoWithContext(ctx context.Context) (resp *Index, err error) {
 	resp = newIndex(i.client, i.UID)
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID,
@@ -91,7 +205,7 @@ func (i Index) FetchInfo() (resp *Index, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "FetchInfo",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	i.PrimaryKey = resp.PrimaryKey //nolint:golint,staticcheck
@@ -99,7 +213,11 @@ func (i Index) FetchInfo() (resp *Index, err error) {
 }
 
 func (i Index) FetchPrimaryKey() (resp *string, err error) {
-	index, err := i.FetchInfo()
+	return i.FetchPrimaryKeyWithContext(context.Background())
+}
+
+func (i Index) FetchPrimaryKeyWithContext(ctx context.Context) (resp *string, err error) {
+	index, err := i.FetchInfoWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +225,10 @@ func (i Index) FetchPrimaryKey() (resp *string, err error) {
 }
 
 func (i Index) UpdateIndex(primaryKey string) (resp *TaskInfo, err error) {
+	return i.UpdateIndexWithContext(context.Background(), primaryKey)
+}
+
+func (i Index) UpdateIndexWithContext(ctx context.Context, primaryKey string) (resp *TaskInfo, err error) {
 	request := &UpdateIndexRequest{
 		PrimaryKey: primaryKey,
 	}
@@ -122,13 +244,17 @@ func (i Index) UpdateIndex(primaryKey string) (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateIndex",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) Delete(uid string) (ok bool, err error) {
+	return i.DeleteWithContext(context.Background(), uid)
+}
+
+func (i Index) DeleteWithContext(ctx context.Context, uid string) (ok bool, err error) {
 	resp := &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + uid,
@@ -139,13 +265,17 @@ func (i Index) Delete(uid string) (ok bool, err error) {
 		functionName:        "Delete",
 	}
 	// err is not nil if status code is not 204 StatusNoContent
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
 func (i Index) GetStats() (resp *StatsIndex, err error) {
+	return i.GetStatsWithContext(context.Background())
+}
+
+func (i Index) GetStatsWithContext(ctx context.Context) (resp *StatsIndex, err error) {
 	resp = &StatsIndex{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/stats",
@@ -155,17 +285,25 @@ func (i Index) GetStats() (resp *StatsIndex, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetStats",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetTask(taskUID int64) (resp *Task, err error) {
-	return i.client.GetTask(taskUID)
+	return i.GetTaskWithContext(context.Background(), taskUID)
+}
+
+func (i Index) GetTaskWithContext(ctx context.Context, taskUID int64) (resp *Task, err error) {
+	return i.client.GetTaskWithContext(ctx, taskUID)
 }
 
 func (i Index) GetTasks(param *TasksQuery) (resp *TaskResult, err error) {
+	return i.GetTasksWithContext(context.Background(), param)
+}
+
+func (i Index) GetTasksWithContext(ctx context.Context, param *TasksQuery) (resp *TaskResult, err error) {
 	resp = &TaskResult{}
 	req := internalRequest{
 		endpoint:            "/tasks",
@@ -196,7 +334,7 @@ func (i Index) GetTasks(param *TasksQuery) (resp *TaskResult, err error) {
 			req.withQueryParams["indexUids"] = i.UID
 		}
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -210,3 +348,10 @@ func (i Index) GetTasks(param *TasksQuery) (resp *TaskResult, err error) {
 func (i Index) WaitForTask(taskUID int64, options ...WaitParams) (*Task, error) {
 	return i.client.WaitForTask(taskUID, options...)
 }
+
+// WaitForTaskWithContext waits for a task to be processed, checking its
+// TaskStatus every interval until the task is done or ctx is canceled.
+// The status is checked every 50ms when interval is not positive.
+func (i Index) WaitForTaskWithContext(ctx context.Context, taskUID int64, interval time.Duration) (*Task, error) {
+	return i.client.WaitForTaskWithContext(ctx, taskUID, interval)
+}
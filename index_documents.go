@@ -3,6 +3,7 @@ package meilisearch
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/csv"
 	"fmt"
 	"io"
@@ -14,6 +15,10 @@ import (
 )
 
 func (i Index) GetDocument(identifier string, request *DocumentQuery, documentPtr interface{}) error {
+	return i.GetDocumentWithContext(context.Background(), identifier, request, documentPtr)
+}
+
+func (i Index) GetDocumentWithContext(ctx context.Context, identifier string, request *DocumentQuery, documentPtr interface{}) error {
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/documents/" + identifier,
 		method:              http.MethodGet,
@@ -28,13 +33,35 @@ func (i Index) GetDocument(identifier string, request *DocumentQuery, documentPt
 			req.withQueryParams["fields"] = strings.Join(request.Fields, ",")
 		}
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (i Index) GetDocuments(request *DocumentsQuery, resp *DocumentsResult) error {
+	return i.GetDocumentsWithContext(context.Background(), request, resp)
+}
+
+func (i Index) GetDocumentsWithContext(ctx context.Context, request *DocumentsQuery, resp *DocumentsResult) error {
+	return i.getDocuments(ctx, request, resp)
+}
+
+// getDocuments fills resp, a pointer to a DocumentsResult or a TypedDocumentsResult, with a page of documents
+func (i Index) getDocuments(ctx context.Context, request *DocumentsQuery, resp interface{}) error {
+	if request != nil && request.Filter != nil {
+		req := internalRequest{
+			endpoint:            "/indexes/" + i.UID + "/documents/fetch",
+			method:              http.MethodPost,
+			contentType:         contentTypeJSON,
+			withRequest:         request,
+			withResponse:        resp,
+			acceptedStatusCodes: []int{http.StatusOK},
+			functionName:        "GetDocuments",
+			readOnly:            true,
+		}
+		return i.client.executeRequest(ctx, req)
+	}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/documents",
 		method:              http.MethodGet,
@@ -55,13 +82,13 @@ func (i Index) GetDocuments(request *DocumentsQuery, resp *DocumentsResult) erro
 			req.withQueryParams["fields"] = strings.Join(request.Fields, ",")
 		}
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (i Index) addDocuments(documentsPtr interface{}, contentType string, primaryKey ...string) (resp *TaskInfo, err error) {
+func (i Index) addDocuments(ctx context.Context, documentsPtr interface{}, contentType string, primaryKey ...string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	endpoint := ""
 	if primaryKey == nil {
@@ -79,17 +106,25 @@ func (i Index) addDocuments(documentsPtr interface{}, contentType string, primar
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "AddDocuments",
 	}
-	if err = i.client.executeRequest(req); err != nil {
+	if err = i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) AddDocuments(documentsPtr interface{}, primaryKey ...string) (resp *TaskInfo, err error) {
-	return i.addDocuments(documentsPtr, contentTypeJSON, primaryKey...)
+	return i.AddDocumentsWithContext(context.Background(), documentsPtr, primaryKey...)
+}
+
+func (i Index) AddDocumentsWithContext(ctx context.Context, documentsPtr interface{}, primaryKey ...string) (resp *TaskInfo, err error) {
+	return i.addDocuments(ctx, documentsPtr, contentTypeJSON, primaryKey...)
 }
 
 func (i Index) AddDocumentsInBatches(documentsPtr interface{}, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
+	return i.AddDocumentsInBatchesWithContext(context.Background(), documentsPtr, batchSize, primaryKey...)
+}
+
+func (i Index) AddDocumentsInBatchesWithContext(ctx context.Context, documentsPtr interface{}, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
 	arr := reflect.ValueOf(documentsPtr)
 	lenDocs := arr.Len()
 	numBatches := int(math.Ceil(float64(lenDocs) / float64(batchSize)))
@@ -104,14 +139,14 @@ func (i Index) AddDocumentsInBatches(documentsPtr interface{}, batchSize int, pr
 		batch := arr.Slice(j*batchSize, end).Interface()
 
 		if primaryKey != nil {
-			respID, err := i.AddDocuments(batch, primaryKey[0])
+			respID, err := i.AddDocumentsWithContext(ctx, batch, primaryKey[0])
 			if err != nil {
 				return nil, err
 			}
 
 			resp[j] = *respID
 		} else {
-			respID, err := i.AddDocuments(batch)
+			respID, err := i.AddDocumentsWithContext(ctx, batch)
 			if err != nil {
 				return nil, err
 			}
@@ -124,26 +159,42 @@ func (i Index) AddDocumentsInBatches(documentsPtr interface{}, batchSize int, pr
 }
 
 func (i Index) AddDocumentsCsv(documents []byte, primaryKey ...string) (resp *TaskInfo, err error) {
+	return i.AddDocumentsCsvWithContext(context.Background(), documents, primaryKey...)
+}
+
+func (i Index) AddDocumentsCsvWithContext(ctx context.Context, documents []byte, primaryKey ...string) (resp *TaskInfo, err error) {
 	// []byte avoids JSON conversion in Client.sendRequest()
-	return i.addDocuments(documents, contentTypeCSV, primaryKey...)
+	return i.addDocuments(ctx, documents, contentTypeCSV, primaryKey...)
 }
 
 func (i Index) AddDocumentsCsvFromReader(documents io.Reader, primaryKey ...string) (resp *TaskInfo, err error) {
+	return i.AddDocumentsCsvFromReaderWithContext(context.Background(), documents, primaryKey...)
+}
+
+func (i Index) AddDocumentsCsvFromReaderWithContext(ctx context.Context, documents io.Reader, primaryKey ...string) (resp *TaskInfo, err error) {
 	// Using io.Reader would avoid JSON conversion in Client.sendRequest(), but
 	// read content to memory anyway because of problems with streamed bodies
 	data, err := io.ReadAll(documents)
 	if err != nil {
 		return nil, fmt.Errorf("could not read documents: %w", err)
 	}
-	return i.addDocuments(data, contentTypeCSV, primaryKey...)
+	return i.addDocuments(ctx, data, contentTypeCSV, primaryKey...)
 }
 
 func (i Index) AddDocumentsCsvInBatches(documents []byte, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
+	return i.AddDocumentsCsvInBatchesWithContext(context.Background(), documents, batchSize, primaryKey...)
+}
+
+func (i Index) AddDocumentsCsvInBatchesWithContext(ctx context.Context, documents []byte, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
 	// Reuse io.Reader implementation
-	return i.AddDocumentsCsvFromReaderInBatches(bytes.NewReader(documents), batchSize, primaryKey...)
+	return i.AddDocumentsCsvFromReaderInBatchesWithContext(ctx, bytes.NewReader(documents), batchSize, primaryKey...)
 }
 
 func (i Index) AddDocumentsCsvFromReaderInBatches(documents io.Reader, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
+	return i.AddDocumentsCsvFromReaderInBatchesWithContext(context.Background(), documents, batchSize, primaryKey...)
+}
+
+func (i Index) AddDocumentsCsvFromReaderInBatchesWithContext(ctx context.Context, documents io.Reader, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
 	// Because of the possibility of multiline fields it's not safe to split
 	// into batches by lines, we'll have to parse the file and reassemble it
 	// into smaller parts. RFC 4180 compliant input with a header row is
@@ -167,7 +218,7 @@ func (i Index) AddDocumentsCsvFromReaderInBatches(documents io.Reader, batchSize
 			return nil, fmt.Errorf("could not write CSV records: %w", err)
 		}
 
-		resp, err := i.AddDocumentsCsv(b.Bytes(), primaryKey...)
+		resp, err := i.AddDocumentsCsvWithContext(ctx, b.Bytes(), primaryKey...)
 		if err != nil {
 			return nil, err
 		}
@@ -222,26 +273,42 @@ func (i Index) AddDocumentsCsvFromReaderInBatches(documents io.Reader, batchSize
 }
 
 func (i Index) AddDocumentsNdjson(documents []byte, primaryKey ...string) (resp *TaskInfo, err error) {
+	return i.AddDocumentsNdjsonWithContext(context.Background(), documents, primaryKey...)
+}
+
+func (i Index) AddDocumentsNdjsonWithContext(ctx context.Context, documents []byte, primaryKey ...string) (resp *TaskInfo, err error) {
 	// []byte avoids JSON conversion in Client.sendRequest()
-	return i.addDocuments([]byte(documents), contentTypeNDJSON, primaryKey...)
+	return i.addDocuments(ctx, []byte(documents), contentTypeNDJSON, primaryKey...)
 }
 
 func (i Index) AddDocumentsNdjsonFromReader(documents io.Reader, primaryKey ...string) (resp *TaskInfo, err error) {
+	return i.AddDocumentsNdjsonFromReaderWithContext(context.Background(), documents, primaryKey...)
+}
+
+func (i Index) AddDocumentsNdjsonFromReaderWithContext(ctx context.Context, documents io.Reader, primaryKey ...string) (resp *TaskInfo, err error) {
 	// Using io.Reader would avoid JSON conversion in Client.sendRequest(), but
 	// read content to memory anyway because of problems with streamed bodies
 	data, err := io.ReadAll(documents)
 	if err != nil {
 		return nil, fmt.Errorf("could not read documents: %w", err)
 	}
-	return i.addDocuments(data, contentTypeNDJSON, primaryKey...)
+	return i.addDocuments(ctx, data, contentTypeNDJSON, primaryKey...)
 }
 
 func (i Index) AddDocumentsNdjsonInBatches(documents []byte, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
+	return i.AddDocumentsNdjsonInBatchesWithContext(context.Background(), documents, batchSize, primaryKey...)
+}
+
+func (i Index) AddDocumentsNdjsonInBatchesWithContext(ctx context.Context, documents []byte, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
 	// Reuse io.Reader implementation
-	return i.AddDocumentsNdjsonFromReaderInBatches(bytes.NewReader(documents), batchSize, primaryKey...)
+	return i.AddDocumentsNdjsonFromReaderInBatchesWithContext(ctx, bytes.NewReader(documents), batchSize, primaryKey...)
 }
 
 func (i Index) AddDocumentsNdjsonFromReaderInBatches(documents io.Reader, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
+	return i.AddDocumentsNdjsonFromReaderInBatchesWithContext(context.Background(), documents, batchSize, primaryKey...)
+}
+
+func (i Index) AddDocumentsNdjsonFromReaderInBatchesWithContext(ctx context.Context, documents io.Reader, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
 	// NDJSON files supposed to contain a valid JSON document in each line, so
 	// it's safe to split by lines.
 	// Lines are read and sent continuously to avoid reading all content into
@@ -261,7 +328,7 @@ func (i Index) AddDocumentsNdjsonFromReaderInBatches(documents io.Reader, batchS
 			}
 		}
 
-		resp, err := i.AddDocumentsNdjson(b.Bytes(), primaryKey...)
+		resp, err := i.AddDocumentsNdjsonWithContext(ctx, b.Bytes(), primaryKey...)
 		if err != nil {
 			return nil, err
 		}
@@ -310,6 +377,10 @@ func (i Index) AddDocumentsNdjsonFromReaderInBatches(documents io.Reader, batchS
 }
 
 func (i Index) UpdateDocuments(documentsPtr interface{}, primaryKey ...string) (resp *TaskInfo, err error) {
+	return i.UpdateDocumentsWithContext(context.Background(), documentsPtr, primaryKey...)
+}
+
+func (i Index) UpdateDocumentsWithContext(ctx context.Context, documentsPtr interface{}, primaryKey ...string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	endpoint := ""
 	if primaryKey == nil {
@@ -327,13 +398,17 @@ func (i Index) UpdateDocuments(documentsPtr interface{}, primaryKey ...string) (
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateDocuments",
 	}
-	if err = i.client.executeRequest(req); err != nil {
+	if err = i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateDocumentsInBatches(documentsPtr interface{}, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
+	return i.UpdateDocumentsInBatchesWithContext(context.Background(), documentsPtr, batchSize, primaryKey...)
+}
+
+func (i Index) UpdateDocumentsInBatchesWithContext(ctx context.Context, documentsPtr interface{}, batchSize int, primaryKey ...string) (resp []TaskInfo, err error) {
 	arr := reflect.ValueOf(documentsPtr)
 	lenDocs := arr.Len()
 	numBatches := int(math.Ceil(float64(lenDocs) / float64(batchSize)))
@@ -347,14 +422,14 @@ func (i Index) UpdateDocumentsInBatches(documentsPtr interface{}, batchSize int,
 
 		batch := arr.Slice(j*batchSize, end).Interface()
 		if primaryKey != nil {
-			respID, err := i.UpdateDocuments(batch, primaryKey[0])
+			respID, err := i.UpdateDocumentsWithContext(ctx, batch, primaryKey[0])
 			if err != nil {
 				return nil, err
 			}
 
 			resp[j] = *respID
 		} else {
-			respID, err := i.UpdateDocuments(batch)
+			respID, err := i.UpdateDocumentsWithContext(ctx, batch)
 			if err != nil {
 				return nil, err
 			}
@@ -367,6 +442,10 @@ func (i Index) UpdateDocumentsInBatches(documentsPtr interface{}, batchSize int,
 }
 
 func (i Index) DeleteDocument(identifier string) (resp *TaskInfo, err error) {
+	return i.DeleteDocumentWithContext(context.Background(), identifier)
+}
+
+func (i Index) DeleteDocumentWithContext(ctx context.Context, identifier string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/documents/" + identifier,
@@ -376,13 +455,17 @@ func (i Index) DeleteDocument(identifier string) (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "DeleteDocument",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) DeleteDocuments(identifier []string) (resp *TaskInfo, err error) {
+	return i.DeleteDocumentsWithContext(context.Background(), identifier)
+}
+
+func (i Index) DeleteDocumentsWithContext(ctx context.Context, identifier []string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/documents/delete-batch",
@@ -393,13 +476,40 @@ func (i Index) DeleteDocuments(identifier []string) (resp *TaskInfo, err error)
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "DeleteDocuments",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteDocumentsByFilter deletes the documents matching the filter, given as a string, an array of
+// strings or a filter.Expression. The attributes used in the filter must be filterable.
+func (i Index) DeleteDocumentsByFilter(filter interface{}) (resp *TaskInfo, err error) {
+	return i.DeleteDocumentsByFilterWithContext(context.Background(), filter)
+}
+
+func (i Index) DeleteDocumentsByFilterWithContext(ctx context.Context, filter interface{}) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/documents/delete",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         map[string]interface{}{"filter": filter},
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "DeleteDocumentsByFilter",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) DeleteAllDocuments() (resp *TaskInfo, err error) {
+	return i.DeleteAllDocumentsWithContext(context.Background())
+}
+
+func (i Index) DeleteAllDocumentsWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/documents",
@@ -409,7 +519,7 @@ func (i Index) DeleteAllDocuments() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "DeleteAllDocuments",
 	}
-	if err = i.client.executeRequest(req); err != nil {
+	if err = i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -0,0 +1,136 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// DefaultIteratorPageSize is the number of documents fetched per request by a
+// DocumentsIterator when DocumentsQuery.Limit is not set
+const DefaultIteratorPageSize int64 = 1000
+
+// ErrNoCurrentDocument is returned when decoding a document before Next returned true or after it returned false
+var ErrNoCurrentDocument = errors.New("DocumentsIterator: no current document, Next must return true first")
+
+// DocumentsIterator pages through the documents of an index, keeping only one
+// page of documents in memory at a time.
+//
+//	it := index.IterateDocuments(&meilisearch.DocumentsQuery{Fields: []string{"id", "title"}})
+//	defer it.Close()
+//	for it.Next() {
+//		doc := it.Doc()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type DocumentsIterator struct {
+	ctx   context.Context
+	index Index
+	query DocumentsQuery
+
+	page     []json.RawMessage
+	position int
+	lastPage bool
+	closed   bool
+	err      error
+}
+
+// documentsPage is a DocumentsResult keeping the documents raw until they are decoded
+type documentsPage struct {
+	Results []json.RawMessage `json:"results"`
+	Limit   int64             `json:"limit"`
+	Offset  int64             `json:"offset"`
+	Total   int64             `json:"total"`
+}
+
+// IterateDocuments returns an iterator over the documents of the index matching the query.
+// DocumentsQuery.Offset is the position of the first document, and DocumentsQuery.Limit
+// the number of documents fetched per request, DefaultIteratorPageSize if not set.
+// DocumentsQuery.Filter only iterates over the documents matching the filter, the
+// attributes it uses must be filterable.
+func (i Index) IterateDocuments(query *DocumentsQuery) *DocumentsIterator {
+	return i.IterateDocumentsWithContext(context.Background(), query)
+}
+
+// IterateDocumentsWithContext is IterateDocuments with the iteration stopping
+// with the error of ctx once it is done.
+func (i Index) IterateDocumentsWithContext(ctx context.Context, query *DocumentsQuery) *DocumentsIterator {
+	it := &DocumentsIterator{
+		ctx:      ctx,
+		index:    i,
+		position: -1,
+	}
+	if query != nil {
+		it.query = *query
+	}
+	if it.query.Limit == 0 {
+		it.query.Limit = DefaultIteratorPageSize
+	}
+	return it
+}
+
+// Next advances the iterator to the next document, fetching the next page of
+// documents when needed. It returns false when there are no more documents,
+// the iterator is closed or an error occurred.
+func (it *DocumentsIterator) Next() bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+	it.position++
+	if it.position < len(it.page) {
+		return true
+	}
+	if it.lastPage {
+		it.page = nil
+		return false
+	}
+
+	page := &documentsPage{}
+	if err := it.index.getDocuments(it.ctx, &it.query, page); err != nil {
+		it.err = err
+		it.page = nil
+		return false
+	}
+	it.page = page.Results
+	it.position = 0
+	it.query.Offset += int64(len(page.Results))
+	if int64(len(page.Results)) < it.query.Limit || it.query.Offset >= page.Total {
+		it.lastPage = true
+	}
+	return len(it.page) > 0
+}
+
+// Doc returns the current document, or nil when there is none or it can not be decoded
+// into a map. A decoding error stops the iteration and is returned by Err, use Decode
+// to handle it without stopping the iteration.
+func (it *DocumentsIterator) Doc() map[string]interface{} {
+	document := map[string]interface{}{}
+	if err := it.Decode(&document); err != nil {
+		if it.err == nil && !errors.Is(err, ErrNoCurrentDocument) {
+			it.err = err
+		}
+		return nil
+	}
+	return document
+}
+
+// Decode decodes the current document into documentPtr
+func (it *DocumentsIterator) Decode(documentPtr interface{}) error {
+	if it.position < 0 || it.position >= len(it.page) {
+		return ErrNoCurrentDocument
+	}
+	return json.Unmarshal(it.page[it.position], documentPtr)
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *DocumentsIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration early, Next returns false afterwards
+func (it *DocumentsIterator) Close() {
+	it.closed = true
+	it.page = nil
+}
@@ -0,0 +1,164 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_IterateDocuments(t *testing.T) {
+	type args struct {
+		UID                  string
+		client               *Client
+		query                *DocumentsQuery
+		filterableAttributes []string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantCount  int
+		wantFields []string
+	}{
+		{
+			name: "TestIndexBasicIterateDocuments",
+			args: args{
+				UID:    "TestIndexBasicIterateDocuments",
+				client: defaultClient,
+			},
+			wantCount:  6,
+			wantFields: []string{"book_id", "title"},
+		},
+		{
+			name: "TestIndexIterateDocumentsWithSeveralPages",
+			args: args{
+				UID:    "TestIndexIterateDocumentsWithSeveralPages",
+				client: customClient,
+				query:  &DocumentsQuery{Limit: 4},
+			},
+			wantCount:  6,
+			wantFields: []string{"book_id", "title"},
+		},
+		{
+			name: "TestIndexIterateDocumentsWithPageSizeDividingTotal",
+			args: args{
+				UID:    "TestIndexIterateDocumentsWithPageSizeDividingTotal",
+				client: defaultClient,
+				query:  &DocumentsQuery{Limit: 2},
+			},
+			wantCount:  6,
+			wantFields: []string{"book_id", "title"},
+		},
+		{
+			name: "TestIndexIterateDocumentsWithOffsetAndFields",
+			args: args{
+				UID:    "TestIndexIterateDocumentsWithOffsetAndFields",
+				client: defaultClient,
+				query:  &DocumentsQuery{Limit: 2, Offset: 3, Fields: []string{"title"}},
+			},
+			wantCount:  3,
+			wantFields: []string{"title"},
+		},
+		{
+			name: "TestIndexIterateDocumentsWithFilter",
+			args: args{
+				UID:                  "TestIndexIterateDocumentsWithFilter",
+				client:               defaultClient,
+				query:                &DocumentsQuery{Limit: 2, Filter: "book_id > 100"},
+				filterableAttributes: []string{"book_id"},
+			},
+			wantCount:  3,
+			wantFields: []string{"book_id", "title"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+			SetUpBasicIndex(tt.args.UID)
+
+			if tt.args.filterableAttributes != nil {
+				task, err := i.UpdateFilterableAttributes(&tt.args.filterableAttributes)
+				require.NoError(t, err)
+				testWaitForTask(t, i, task)
+			}
+
+			it := i.IterateDocuments(tt.args.query)
+			defer it.Close()
+
+			count := 0
+			for it.Next() {
+				doc := it.Doc()
+				require.Len(t, doc, len(tt.wantFields))
+				for _, field := range tt.wantFields {
+					require.Contains(t, doc, field)
+				}
+				count++
+			}
+			require.NoError(t, it.Err())
+			require.Equal(t, tt.wantCount, count)
+			require.False(t, it.Next())
+			require.ErrorIs(t, it.Decode(&docTestBooks{}), ErrNoCurrentDocument)
+		})
+	}
+}
+
+func TestIndex_IterateDocumentsStopEarly(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexIterateDocumentsStopEarly")
+	t.Cleanup(cleanup(c))
+	SetUpBasicIndex("TestIndexIterateDocumentsStopEarly")
+
+	it := i.IterateDocuments(&DocumentsQuery{Limit: 2})
+	require.True(t, it.Next())
+
+	var doc docTestBooks
+	require.NoError(t, it.Decode(&doc))
+	require.NotZero(t, doc.BookID)
+
+	it.Close()
+	require.False(t, it.Next())
+	require.NoError(t, it.Err())
+}
+
+func TestIndex_IterateDocumentsWithContext(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexIterateDocumentsWithContext")
+	t.Cleanup(cleanup(c))
+	SetUpBasicIndex("TestIndexIterateDocumentsWithContext")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := i.IterateDocumentsWithContext(ctx, &DocumentsQuery{Limit: 2})
+	require.True(t, it.Next())
+	require.True(t, it.Next())
+
+	cancel()
+	require.False(t, it.Next())
+	require.Error(t, it.Err())
+	require.ErrorIs(t, it.Err().(*Error).OriginError, context.Canceled)
+}
+
+func TestIndex_IterateDocumentsDocWithDecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[{"id":1},"not a document",{"id":3}],"limit":3,"offset":0,"total":3}`))
+	}))
+	defer server.Close()
+
+	it := NewClient(ClientConfig{Host: server.URL}).Index("movies").IterateDocuments(&DocumentsQuery{Limit: 3})
+	require.True(t, it.Next())
+	require.Equal(t, map[string]interface{}{"id": float64(1)}, it.Doc())
+	require.True(t, it.Next())
+
+	var raw json.RawMessage
+	require.NoError(t, it.Decode(&raw))
+	require.NoError(t, it.Err())
+
+	require.Nil(t, it.Doc())
+	var unmarshalErr *json.UnmarshalTypeError
+	require.ErrorAs(t, it.Err(), &unmarshalErr)
+	require.False(t, it.Next())
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/meilisearch/meilisearch-go/filter"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1265,6 +1266,99 @@ func TestIndex_DeleteDocuments(t *testing.T) {
 	}
 }
 
+func TestIndex_DeleteDocumentsByFilter(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+		filter interface{}
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantDeleted int64
+	}{
+		{
+			name: "TestIndexDeleteDocumentsByFilterString",
+			args: args{
+				UID:    "TestIndexDeleteDocumentsByFilterString",
+				client: defaultClient,
+				filter: "book_id > 400",
+			},
+			wantDeleted: 2,
+		},
+		{
+			name: "TestIndexDeleteDocumentsByFilterArray",
+			args: args{
+				UID:    "TestIndexDeleteDocumentsByFilterArray",
+				client: customClient,
+				filter: []interface{}{[]string{"book_id = 1", "book_id = 4"}, "title EXISTS"},
+			},
+			wantDeleted: 2,
+		},
+		{
+			name: "TestIndexDeleteDocumentsByFilterExpression",
+			args: args{
+				UID:    "TestIndexDeleteDocumentsByFilterExpression",
+				client: defaultClient,
+				filter: filter.Or(filter.Eq("title", "The Hitchhiker's Guide to the Galaxy"), filter.Between("book_id", 100, 200)),
+			},
+			wantDeleted: 2,
+		},
+		{
+			name: "TestIndexDeleteDocumentsByFilterWithoutMatch",
+			args: args{
+				UID:    "TestIndexDeleteDocumentsByFilterWithoutMatch",
+				client: defaultClient,
+				filter: "book_id = 0",
+			},
+			wantDeleted: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+			SetUpBasicIndex(tt.args.UID)
+
+			task, err := i.UpdateFilterableAttributes(&[]string{"book_id", "title"})
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotResp, err := i.DeleteDocumentsByFilter(tt.args.filter)
+			require.NoError(t, err)
+			require.Equal(t, TaskStatusEnqueued, gotResp.Status)
+			require.Equal(t, "documentDeletion", gotResp.Type)
+
+			gotTask, err := i.WaitForTask(gotResp.TaskUID)
+			require.NoError(t, err)
+			require.Equal(t, TaskStatusSucceeded, gotTask.Status)
+			require.Equal(t, tt.wantDeleted, gotTask.Details.DeletedDocuments)
+			require.NotEmpty(t, gotTask.Details.OriginalFilter)
+
+			var documents DocumentsResult
+			err = i.GetDocuments(nil, &documents)
+			require.NoError(t, err)
+			require.Equal(t, 6-tt.wantDeleted, documents.Total)
+		})
+	}
+}
+
+func TestIndex_DeleteDocumentsByFilterWithInvalidFilter(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexDeleteDocumentsByFilterWithInvalidFilter")
+	t.Cleanup(cleanup(c))
+	SetUpBasicIndex("TestIndexDeleteDocumentsByFilterWithInvalidFilter")
+
+	gotResp, err := i.DeleteDocumentsByFilter("book_id = 1")
+	require.NoError(t, err)
+
+	gotTask, err := i.WaitForTask(gotResp.TaskUID)
+	require.NoError(t, err)
+	require.Equal(t, TaskStatusFailed, gotTask.Status)
+	require.Equal(t, "invalid_document_filter", gotTask.Error.Code)
+}
+
 func TestIndex_GetDocument(t *testing.T) {
 	type args struct {
 		UID         string
@@ -1436,6 +1530,93 @@ func TestIndex_GetDocuments(t *testing.T) {
 	}
 }
 
+func TestIndex_GetDocumentsWithFilter(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *DocumentsQuery
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantTotal int64
+		wantLen   int
+	}{
+		{
+			name: "TestIndexGetDocumentsWithFilterString",
+			args: args{
+				UID:     "TestIndexGetDocumentsWithFilterString",
+				client:  defaultClient,
+				request: &DocumentsQuery{Filter: "book_id > 100"},
+			},
+			wantTotal: 3,
+			wantLen:   3,
+		},
+		{
+			name: "TestIndexGetDocumentsWithFilterArray",
+			args: args{
+				UID:    "TestIndexGetDocumentsWithFilterArray",
+				client: customClient,
+				request: &DocumentsQuery{
+					Filter: []interface{}{[]string{"book_id = 1", "book_id = 4"}, "title EXISTS"},
+				},
+			},
+			wantTotal: 2,
+			wantLen:   2,
+		},
+		{
+			name: "TestIndexGetDocumentsWithFilterExpressionAndPagination",
+			args: args{
+				UID:    "TestIndexGetDocumentsWithFilterExpressionAndPagination",
+				client: defaultClient,
+				request: &DocumentsQuery{
+					Filter: filter.Lt("book_id", 1000),
+					Limit:  2,
+					Offset: 1,
+					Fields: []string{"book_id"},
+				},
+			},
+			wantTotal: 5,
+			wantLen:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+			SetUpBasicIndex(tt.args.UID)
+
+			task, err := i.UpdateFilterableAttributes(&[]string{"book_id", "title"})
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			var resp DocumentsResult
+			err = i.GetDocuments(tt.args.request, &resp)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTotal, resp.Total)
+			require.Len(t, resp.Results, tt.wantLen)
+			for _, document := range resp.Results {
+				if len(tt.args.request.Fields) != 0 {
+					require.Len(t, document, len(tt.args.request.Fields))
+				}
+			}
+		})
+	}
+}
+
+func TestIndex_GetDocumentsWithFilterNotFilterable(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexGetDocumentsWithFilterNotFilterable")
+	t.Cleanup(cleanup(c))
+	SetUpBasicIndex("TestIndexGetDocumentsWithFilterNotFilterable")
+
+	var resp DocumentsResult
+	err := i.GetDocuments(&DocumentsQuery{Filter: "book_id = 1"}, &resp)
+	require.Error(t, err)
+	require.Equal(t, "invalid_document_filter", err.(*Error).MeilisearchApiError.Code)
+}
+
 func TestIndex_UpdateDocuments(t *testing.T) {
 	type args struct {
 		UID          string
@@ -0,0 +1,39 @@
+package meilisearch
+
+import (
+	"context"
+)
+
+// TypedDocumentsResult is the response body for list documents method with documents decoded into T
+type TypedDocumentsResult[T any] struct {
+	Results []T   `json:"results"`
+	Limit   int64 `json:"limit"`
+	Offset  int64 `json:"offset"`
+	Total   int64 `json:"total"`
+}
+
+// GetDocumentTyped gets one document of the index like Index.GetDocument, decoding it into T
+func GetDocumentTyped[T any](index *Index, identifier string, request *DocumentQuery) (*T, error) {
+	return GetDocumentTypedWithContext[T](context.Background(), index, identifier, request)
+}
+
+func GetDocumentTypedWithContext[T any](ctx context.Context, index *Index, identifier string, request *DocumentQuery) (*T, error) {
+	resp := new(T)
+	if err := index.GetDocumentWithContext(ctx, identifier, request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetDocumentsTyped gets a page of documents of the index like Index.GetDocuments, decoding them into T
+func GetDocumentsTyped[T any](index *Index, request *DocumentsQuery) (*TypedDocumentsResult[T], error) {
+	return GetDocumentsTypedWithContext[T](context.Background(), index, request)
+}
+
+func GetDocumentsTypedWithContext[T any](ctx context.Context, index *Index, request *DocumentsQuery) (*TypedDocumentsResult[T], error) {
+	resp := &TypedDocumentsResult[T]{}
+	if err := index.getDocuments(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,123 @@
+package meilisearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_GetDocumentTyped(t *testing.T) {
+	type args struct {
+		UID        string
+		client     *Client
+		identifier string
+		request    *DocumentQuery
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *docTestBooks
+		wantErr bool
+	}{
+		{
+			name: "TestIndexBasicGetDocumentTyped",
+			args: args{
+				UID:        "TestIndexBasicGetDocumentTyped",
+				client:     defaultClient,
+				identifier: "123",
+			},
+			want: &docTestBooks{BookID: 123, Title: "Pride and Prejudice"},
+		},
+		{
+			name: "TestIndexGetDocumentTypedWithFields",
+			args: args{
+				UID:        "TestIndexGetDocumentTypedWithFields",
+				client:     customClient,
+				identifier: "456",
+				request:    &DocumentQuery{Fields: []string{"book_id"}},
+			},
+			want: &docTestBooks{BookID: 456},
+		},
+		{
+			name: "TestIndexGetDocumentTypedWithNoExistingDocument",
+			args: args{
+				UID:        "TestIndexGetDocumentTypedWithNoExistingDocument",
+				client:     defaultClient,
+				identifier: "125",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+			SetUpBasicIndex(tt.args.UID)
+
+			got, err := GetDocumentTyped[docTestBooks](i, tt.args.identifier, tt.args.request)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIndex_GetDocumentsTyped(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request *DocumentsQuery
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantLen   int
+		wantTotal int64
+	}{
+		{
+			name: "TestIndexBasicGetDocumentsTyped",
+			args: args{
+				UID:    "TestIndexBasicGetDocumentsTyped",
+				client: defaultClient,
+			},
+			wantLen:   6,
+			wantTotal: 6,
+		},
+		{
+			name: "TestIndexGetDocumentsTypedWithLimitAndOffset",
+			args: args{
+				UID:     "TestIndexGetDocumentsTypedWithLimitAndOffset",
+				client:  customClient,
+				request: &DocumentsQuery{Limit: 2, Offset: 1},
+			},
+			wantLen:   2,
+			wantTotal: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+			SetUpBasicIndex(tt.args.UID)
+
+			got, err := GetDocumentsTyped[docTestBooks](i, tt.args.request)
+			require.NoError(t, err)
+			require.Len(t, got.Results, tt.wantLen)
+			require.Equal(t, tt.wantTotal, got.Total)
+			if tt.args.request != nil {
+				require.Equal(t, tt.args.request.Limit, got.Limit)
+				require.Equal(t, tt.args.request.Offset, got.Offset)
+			}
+			for _, document := range got.Results {
+				require.NotZero(t, document.BookID)
+				require.NotEmpty(t, document.Title)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package meilisearch
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+
+	"github.com/meilisearch/meilisearch-go/filter"
 )
 
 // This constant contains the default values assigned by Meilisearch to the limit in search parameters
@@ -13,6 +16,10 @@ const (
 )
 
 func (i Index) SearchRaw(query string, request *SearchRequest) (*json.RawMessage, error) {
+	return i.SearchRawWithContext(context.Background(), query, request)
+}
+
+func (i Index) SearchRawWithContext(ctx context.Context, query string, request *SearchRequest) (*json.RawMessage, error) {
 	resp := &json.RawMessage{}
 
 	if request.Limit == 0 {
@@ -29,9 +36,10 @@ func (i Index) SearchRaw(query string, request *SearchRequest) (*json.RawMessage
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "SearchRaw",
+		readOnly:            true,
 	}
 
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 
@@ -39,6 +47,10 @@ func (i Index) SearchRaw(query string, request *SearchRequest) (*json.RawMessage
 }
 
 func (i Index) Search(query string, request *SearchRequest) (*SearchResponse, error) {
+	return i.SearchWithContext(context.Background(), query, request)
+}
+
+func (i Index) SearchWithContext(ctx context.Context, query string, request *SearchRequest) (*SearchResponse, error) {
 	resp := &SearchResponse{}
 
 	if request.Limit == 0 {
@@ -55,15 +67,99 @@ func (i Index) Search(query string, request *SearchRequest) (*SearchResponse, er
 		withResponse:        resp,
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "Search",
+		readOnly:            true,
+	}
+
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// FacetSearch searches facetQuery in the values of the facetName facet, among the
+// documents matching the request. The facet must be in the filterable attributes.
+func (i Index) FacetSearch(facetName, facetQuery string, request *FacetSearchRequest) (*FacetSearchResponse, error) {
+	return i.FacetSearchWithContext(context.Background(), facetName, facetQuery, request)
+}
+
+func (i Index) FacetSearchWithContext(ctx context.Context, facetName, facetQuery string, request *FacetSearchRequest) (*FacetSearchResponse, error) {
+	resp := &FacetSearchResponse{}
+
+	facetSearchRequest := FacetSearchRequest{}
+	if request != nil {
+		facetSearchRequest = *request
+	}
+	facetSearchRequest.FacetName = facetName
+	facetSearchRequest.FacetQuery = facetQuery
+
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/facet-search",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         &facetSearchRequest,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "FacetSearch",
+		readOnly:            true,
+	}
+
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SearchSimilarDocuments returns the documents the most similar to the document identified by id,
+// according to the embedder of the query. The document itself is not among the hits.
+func (i Index) SearchSimilarDocuments(id string, query *SimilarDocumentsQuery) (*SimilarDocumentsResponse, error) {
+	return i.SearchSimilarDocumentsWithContext(context.Background(), id, query)
+}
+
+func (i Index) SearchSimilarDocumentsWithContext(ctx context.Context, id string, query *SimilarDocumentsQuery) (*SimilarDocumentsResponse, error) {
+	resp := &SimilarDocumentsResponse{}
+
+	similarDocumentsQuery := SimilarDocumentsQuery{}
+	if query != nil {
+		similarDocumentsQuery = *query
+	}
+	similarDocumentsQuery.ID = id
+
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/similar",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         &similarDocumentsQuery,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "SearchSimilarDocuments",
+		readOnly:            true,
 	}
 
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 
 	return resp, nil
 }
 
+// ValidateFilter checks the filter expression before it is sent to Meilisearch. It returns a
+// *filter.SyntaxError when the expression can not be parsed, and a *filter.AttributeError
+// when it uses an attribute missing from the filterable attributes of the index.
+func (i Index) ValidateFilter(expression string) error {
+	return i.ValidateFilterWithContext(context.Background(), expression)
+}
+
+func (i Index) ValidateFilterWithContext(ctx context.Context, expression string) error {
+	filterableAttributes, err := i.GetFilterableAttributesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = filter.ParseAndValidate(expression, *filterableAttributes)
+	return err
+}
+
 func searchPostRequestParams(query string, request *SearchRequest) map[string]interface{} {
 	params := make(map[string]interface{}, 14)
 
@@ -118,6 +214,100 @@ func searchPostRequestParams(query string, request *SearchRequest) map[string]in
 	if len(request.Sort) != 0 {
 		params["sort"] = request.Sort
 	}
+	if request.FederationOptions != nil {
+		params["federationOptions"] = request.FederationOptions
+	}
+	if request.ShowRankingScore {
+		params["showRankingScore"] = request.ShowRankingScore
+	}
+	if request.ShowRankingScoreDetails {
+		params["showRankingScoreDetails"] = request.ShowRankingScoreDetails
+	}
+	if request.RankingScoreThreshold != 0 {
+		params["rankingScoreThreshold"] = request.RankingScoreThreshold
+	}
+	if len(request.Vector) != 0 {
+		params["vector"] = request.Vector
+	}
+	if request.Hybrid != nil {
+		params["hybrid"] = request.Hybrid
+	}
+	if request.RetrieveVectors {
+		params["retrieveVectors"] = request.RetrieveVectors
+	}
 
 	return params
 }
+
+// HitsFederation returns the `_federation` field of each hit of a federated multi search, in the order of the hits.
+// It is left empty for the hits without this field.
+func (r *SearchResponse) HitsFederation() []HitFederation {
+	federations := make([]HitFederation, len(r.Hits))
+	for i, hit := range r.Hits {
+		document, ok := hit.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		federation, ok := document["_federation"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		federations[i].IndexUID, _ = federation["indexUid"].(string)
+		if position, ok := federation["queriesPosition"].(float64); ok {
+			federations[i].QueriesPosition = int64(position)
+		}
+		federations[i].WeightedRankingScore, _ = federation["weightedRankingScore"].(float64)
+	}
+	return federations
+}
+
+// HitsRankingScore returns the `_rankingScore` field of each hit, set with SearchRequest.ShowRankingScore,
+// in the order of the hits. It is left to 0 for the hits without this field.
+func (r *SearchResponse) HitsRankingScore() []float64 {
+	return hitsRankingScore(r.Hits)
+}
+
+// HitsRankingScoreDetails returns the `_rankingScoreDetails` field of each hit, set with
+// SearchRequest.ShowRankingScoreDetails, in the order of the hits. It is left nil for the
+// hits without this field.
+func (r *SearchResponse) HitsRankingScoreDetails() []map[string]RankingScoreDetail {
+	return hitsRankingScoreDetails(r.Hits)
+}
+
+// HitsRankingScore returns the `_rankingScore` field of each hit, see SearchResponse.HitsRankingScore
+func (r *SimilarDocumentsResponse) HitsRankingScore() []float64 {
+	return hitsRankingScore(r.Hits)
+}
+
+// HitsRankingScoreDetails returns the `_rankingScoreDetails` field of each hit, see SearchResponse.HitsRankingScoreDetails
+func (r *SimilarDocumentsResponse) HitsRankingScoreDetails() []map[string]RankingScoreDetail {
+	return hitsRankingScoreDetails(r.Hits)
+}
+
+func hitsRankingScore(hits []interface{}) []float64 {
+	scores := make([]float64, len(hits))
+	for i, hit := range hits {
+		if document, ok := hit.(map[string]interface{}); ok {
+			scores[i], _ = document["_rankingScore"].(float64)
+		}
+	}
+	return scores
+}
+
+func hitsRankingScoreDetails(hits []interface{}) []map[string]RankingScoreDetail {
+	details := make([]map[string]RankingScoreDetail, len(hits))
+	for i, hit := range hits {
+		document, ok := hit.(map[string]interface{})
+		if !ok || document["_rankingScoreDetails"] == nil {
+			continue
+		}
+		raw, err := json.Marshal(document["_rankingScoreDetails"])
+		if err != nil {
+			continue
+		}
+		if err := json.Unmarshal(raw, &details[i]); err != nil {
+			details[i] = nil
+		}
+	}
+	return details
+}
@@ -1,9 +1,12 @@
 package meilisearch
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/meilisearch/meilisearch-go/filter"
 	"github.com/stretchr/testify/require"
 )
 
@@ -446,6 +449,355 @@ func TestIndex_Search(t *testing.T) {
 	}
 }
 
+func TestIndex_SearchWithContext(t *testing.T) {
+	c := defaultClient
+	t.Cleanup(cleanup(c))
+	SetUpIndexForFaceting()
+	i := c.Index("indexUID")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	got, err := i.SearchWithContext(ctx, "prince", &SearchRequest{})
+	require.NoError(t, err)
+	require.Len(t, got.Hits, 2)
+
+	canceledCtx, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	got, err = i.SearchWithContext(canceledCtx, "prince", &SearchRequest{})
+	require.Error(t, err)
+	require.Nil(t, got)
+	require.ErrorIs(t, err.(*Error).OriginError, context.Canceled)
+}
+
+func TestSearchResponse_HitsFederation(t *testing.T) {
+	resp := &SearchResponse{}
+	err := resp.UnmarshalJSON([]byte(`{
+		"hits": [
+			{"id": 1, "_federation": {"indexUid": "movies", "queriesPosition": 1, "weightedRankingScore": 0.9}},
+			{"id": 2}
+		]
+	}`))
+	require.NoError(t, err)
+
+	got := resp.HitsFederation()
+	require.Equal(t, []HitFederation{
+		{IndexUID: "movies", QueriesPosition: 1, WeightedRankingScore: 0.9},
+		{},
+	}, got)
+}
+
+func TestSearchResponse_HitsRankingScore(t *testing.T) {
+	resp := &SearchResponse{}
+	err := resp.UnmarshalJSON([]byte(`{
+		"hits": [
+			{
+				"id": 1,
+				"_rankingScore": 0.9,
+				"_rankingScoreDetails": {
+					"words": {"order": 0, "matchingWords": 2, "maxMatchingWords": 2, "score": 1.0},
+					"typo": {"order": 1, "typoCount": 1, "maxTypoCount": 2, "score": 0.5},
+					"exactness": {"order": 2, "matchType": "noExactMatch", "score": 0.33},
+					"year:asc": {"order": 3, "value": 1925}
+				}
+			},
+			{"id": 2}
+		]
+	}`))
+	require.NoError(t, err)
+
+	require.Equal(t, []float64{0.9, 0}, resp.HitsRankingScore())
+	require.Equal(t, []map[string]RankingScoreDetail{
+		{
+			"words":     {Order: 0, MatchingWords: 2, MaxMatchingWords: 2, Score: 1},
+			"typo":      {Order: 1, TypoCount: 1, MaxTypoCount: 2, Score: 0.5},
+			"exactness": {Order: 2, MatchType: "noExactMatch", Score: 0.33},
+			"year:asc":  {Order: 3, Value: float64(1925)},
+		},
+		nil,
+	}, resp.HitsRankingScoreDetails())
+}
+
+func TestIndex_SearchWithRankingScore(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		query   string
+		request SearchRequest
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantScore   bool
+		wantDetails bool
+	}{
+		{
+			name: "TestIndexSearchWithShowRankingScore",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				query:   "prince",
+				request: SearchRequest{ShowRankingScore: true},
+			},
+			wantScore: true,
+		},
+		{
+			name: "TestIndexSearchWithShowRankingScoreDetails",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				query:   "prince",
+				request: SearchRequest{ShowRankingScoreDetails: true},
+			},
+			wantDetails: true,
+		},
+		{
+			name: "TestIndexSearchWithRankingScoreThreshold",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				query:  "the prince",
+				request: SearchRequest{
+					ShowRankingScore:        true,
+					ShowRankingScoreDetails: true,
+					RankingScoreThreshold:   0.5,
+				},
+			},
+			wantScore:   true,
+			wantDetails: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			got, err := i.Search(tt.args.query, &tt.args.request)
+			require.NoError(t, err)
+			require.NotEmpty(t, got.Hits)
+
+			scores := got.HitsRankingScore()
+			details := got.HitsRankingScoreDetails()
+			for j := range got.Hits {
+				if tt.wantScore {
+					require.Greater(t, scores[j], 0.0)
+					require.GreaterOrEqual(t, scores[j], tt.args.request.RankingScoreThreshold)
+				} else {
+					require.Zero(t, scores[j])
+				}
+				if tt.wantDetails {
+					require.Contains(t, details[j], "words")
+				} else {
+					require.Nil(t, details[j])
+				}
+			}
+
+			typed, err := SearchTyped[docTestBooks](i, tt.args.query, &tt.args.request)
+			require.NoError(t, err)
+			for j, hit := range typed.Hits {
+				require.Equal(t, scores[j], hit.RankingScore)
+				require.Equal(t, details[j], hit.RankingScoreDetails)
+			}
+		})
+	}
+}
+
+func TestSearchPostRequestParams_Vectors(t *testing.T) {
+	params := searchPostRequestParams("dark knight", &SearchRequest{
+		Limit:           DefaultLimit,
+		Vector:          []float32{0.1, 0.2, 0.3},
+		Hybrid:          &SearchRequestHybrid{Embedder: "default", SemanticRatio: 0.8},
+		RetrieveVectors: true,
+	})
+	got, err := json.Marshal(params)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"q": "dark knight",
+		"vector": [0.1, 0.2, 0.3],
+		"hybrid": {"embedder": "default", "semanticRatio": 0.8},
+		"retrieveVectors": true
+	}`, string(got))
+
+	params = searchPostRequestParams("dark knight", &SearchRequest{Limit: DefaultLimit})
+	require.NotContains(t, params, "vector")
+	require.NotContains(t, params, "hybrid")
+	require.NotContains(t, params, "retrieveVectors")
+}
+
+func TestEmbedderVectors_MarshalJSON(t *testing.T) {
+	type document struct {
+		ID      int                        `json:"id"`
+		Vectors map[string]EmbedderVectors `json:"_vectors"`
+	}
+	got, err := json.Marshal([]document{
+		{ID: 1, Vectors: map[string]EmbedderVectors{"default": {Embeddings: [][]float32{{0.1, 0.2}}}}},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"id": 1, "_vectors": {"default": {"embeddings": [[0.1, 0.2]], "regenerate": false}}}]`, string(got))
+}
+
+func TestIndex_SearchWithVectors(t *testing.T) {
+	type document struct {
+		ID      int                        `json:"id"`
+		Title   string                     `json:"title"`
+		Vectors map[string]EmbedderVectors `json:"_vectors"`
+	}
+	c := defaultClient
+	i := c.Index("TestIndexSearchWithVectors")
+	t.Cleanup(cleanup(c))
+
+	task, err := i.UpdateEmbedders(&map[string]Embedder{
+		"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+	})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	task, err = i.AddDocuments([]document{
+		{ID: 1, Title: "Dune", Vectors: map[string]EmbedderVectors{"default": {Embeddings: [][]float32{{1, 0, 0}}}}},
+		{ID: 2, Title: "Foundation", Vectors: map[string]EmbedderVectors{"default": {Embeddings: [][]float32{{0, 1, 0}}}}},
+		{ID: 3, Title: "Hyperion", Vectors: map[string]EmbedderVectors{"default": {Embeddings: [][]float32{{0, 0, 1}}}}},
+	})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	request := &SearchRequest{
+		Vector:          []float32{0.1, 0.9, 0.1},
+		Hybrid:          &SearchRequestHybrid{Embedder: "default", SemanticRatio: 1},
+		RetrieveVectors: true,
+	}
+	got, err := SearchTyped[document](i, "", request)
+	require.NoError(t, err)
+	require.Len(t, got.Hits, 3)
+	require.Equal(t, 2, got.Hits[0].Document.ID)
+	require.Equal(t, int64(3), got.SemanticHitCount)
+	require.Equal(t, [][]float32{{0, 1, 0}}, got.Hits[0].Vectors["default"].Embeddings)
+
+	request.RetrieveVectors = false
+	gotUntyped, err := i.Search("", request)
+	require.NoError(t, err)
+	require.Len(t, gotUntyped.Hits, 3)
+	require.Equal(t, float64(2), gotUntyped.Hits[0].(map[string]interface{})["id"])
+	require.NotContains(t, gotUntyped.Hits[0], "_vectors")
+}
+
+func TestIndex_SearchSimilarDocuments(t *testing.T) {
+	type args struct {
+		client *Client
+		id     string
+		query  *SimilarDocumentsQuery
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantIDs   []float64
+		wantScore bool
+	}{
+		{
+			name: "TestIndexBasicSearchSimilarDocuments",
+			args: args{
+				client: defaultClient,
+				id:     "1",
+				query:  &SimilarDocumentsQuery{Embedder: "default"},
+			},
+			wantIDs: []float64{2, 4, 3},
+		},
+		{
+			name: "TestIndexSearchSimilarDocumentsWithPagination",
+			args: args{
+				client: customClient,
+				id:     "1",
+				query:  &SimilarDocumentsQuery{Embedder: "default", Limit: 1, Offset: 1},
+			},
+			wantIDs: []float64{4},
+		},
+		{
+			name: "TestIndexSearchSimilarDocumentsWithFilterAndAttributes",
+			args: args{
+				client: defaultClient,
+				id:     "3",
+				query: &SimilarDocumentsQuery{
+					Embedder:             "default",
+					Filter:               filter.Eq("genre", "space opera"),
+					AttributesToRetrieve: []string{"id"},
+				},
+			},
+			wantIDs: []float64{4, 1},
+		},
+		{
+			name: "TestIndexSearchSimilarDocumentsWithRankingScore",
+			args: args{
+				client: defaultClient,
+				id:     "1",
+				query: &SimilarDocumentsQuery{
+					Embedder:                "default",
+					ShowRankingScore:        true,
+					ShowRankingScoreDetails: true,
+					RankingScoreThreshold:   0.6,
+				},
+			},
+			wantIDs:   []float64{2, 4},
+			wantScore: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := tt.args.client
+			i := c.Index("TestIndexSearchSimilarDocuments")
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateSettings(&Settings{
+				FilterableAttributes: []string{"genre"},
+				Embedders: map[string]Embedder{
+					"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+				},
+			})
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			task, err = i.AddDocuments([]map[string]interface{}{
+				{"id": 1, "genre": "space opera", "_vectors": map[string]interface{}{"default": []float32{1, 0, 0}}},
+				{"id": 2, "genre": "cyberpunk", "_vectors": map[string]interface{}{"default": []float32{0.9, 0.1, 0}}},
+				{"id": 3, "genre": "space opera", "_vectors": map[string]interface{}{"default": []float32{0, 0, 1}}},
+				{"id": 4, "genre": "space opera", "_vectors": map[string]interface{}{"default": []float32{0.5, 0.5, 0.2}}},
+			})
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			got, err := i.SearchSimilarDocuments(tt.args.id, tt.args.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.args.id, got.ID)
+			require.Len(t, got.Hits, len(tt.wantIDs))
+			for j, hit := range got.Hits {
+				document := hit.(map[string]interface{})
+				require.Equal(t, tt.wantIDs[j], document["id"])
+				if len(tt.args.query.AttributesToRetrieve) != 0 {
+					require.NotContains(t, document, "genre")
+				}
+			}
+			for j, score := range got.HitsRankingScore() {
+				if tt.wantScore {
+					require.GreaterOrEqual(t, score, tt.args.query.RankingScoreThreshold)
+					require.NotEmpty(t, got.HitsRankingScoreDetails()[j])
+				} else {
+					require.Zero(t, score)
+				}
+			}
+		})
+	}
+}
+
+func TestIndex_SearchSimilarDocumentsWithUnknownEmbedder(t *testing.T) {
+	c := defaultClient
+	i := c.Index("TestIndexSearchSimilarDocumentsWithUnknownEmbedder")
+	t.Cleanup(cleanup(c))
+	SetUpBasicIndex("TestIndexSearchSimilarDocumentsWithUnknownEmbedder")
+
+	got, err := i.SearchSimilarDocuments("123", &SimilarDocumentsQuery{Embedder: "unknown"})
+	require.Error(t, err)
+	require.Nil(t, got)
+}
+
 func TestIndex_SearchFacets(t *testing.T) {
 	type args struct {
 		UID                  string
@@ -483,13 +835,12 @@ func TestIndex_SearchFacets(t *testing.T) {
 				EstimatedTotalHits: 2,
 				Offset:             0,
 				Limit:              20,
-				FacetDistribution: map[string]interface{}(
-					map[string]interface{}{
-						"tag": map[string]interface{}{
-							"Epic fantasy": float64(1),
-							"Tale":         float64(1),
-						},
-					}),
+				FacetDistribution: map[string]map[string]int64{
+					"tag": {
+						"Epic fantasy": 1,
+						"Tale":         1,
+					},
+				},
 			},
 		},
 		{
@@ -515,13 +866,50 @@ func TestIndex_SearchFacets(t *testing.T) {
 				EstimatedTotalHits: 2,
 				Offset:             0,
 				Limit:              20,
-				FacetDistribution: map[string]interface{}(
+				FacetDistribution: map[string]map[string]int64{
+					"tag": {
+						"Epic fantasy": 1,
+						"Tale":         1,
+					},
+				},
+			},
+		},
+		{
+			name: "TestIndexSearchWithFacetStats",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				query:  "prince",
+				request: SearchRequest{
+					Facets: []string{"tag", "year"},
+				},
+				filterableAttributes: []string{"tag", "year"},
+			},
+			want: &SearchResponse{
+				Hits: []interface{}{
 					map[string]interface{}{
-						"tag": map[string]interface{}{
-							"Epic fantasy": float64(1),
-							"Tale":         float64(1),
-						},
-					}),
+						"book_id": float64(456), "title": "Le Petit Prince",
+					},
+					map[string]interface{}{
+						"book_id": float64(4), "title": "Harry Potter and the Half-Blood Prince",
+					},
+				},
+				EstimatedTotalHits: 2,
+				Offset:             0,
+				Limit:              20,
+				FacetDistribution: map[string]map[string]int64{
+					"tag": {
+						"Epic fantasy": 1,
+						"Tale":         1,
+					},
+					"year": {
+						"1943": 1,
+						"2005": 1,
+					},
+				},
+				FacetStats: map[string]FacetStat{
+					"year": {Min: 1943, Max: 2005},
+				},
 			},
 		},
 	}
@@ -548,10 +936,107 @@ func TestIndex_SearchFacets(t *testing.T) {
 			require.Equal(t, tt.want.Offset, got.Offset)
 			require.Equal(t, tt.want.Limit, got.Limit)
 			require.Equal(t, tt.want.FacetDistribution, got.FacetDistribution)
+			require.Equal(t, tt.want.FacetStats, got.FacetStats)
 		})
 	}
 }
 
+func TestIndex_FacetSearch(t *testing.T) {
+	type args struct {
+		client     *Client
+		facetName  string
+		facetQuery string
+		request    *FacetSearchRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		want []FacetHit
+	}{
+		{
+			name: "TestIndexBasicFacetSearch",
+			args: args{
+				client:     defaultClient,
+				facetName:  "tag",
+				facetQuery: "epic",
+			},
+			want: []FacetHit{
+				{Value: "Epic", Count: 2},
+				{Value: "Epic fantasy", Count: 3},
+			},
+		},
+		{
+			name: "TestIndexFacetSearchWithCustomClient",
+			args: args{
+				client:     customClient,
+				facetName:  "tag",
+				facetQuery: "trag",
+			},
+			want: []FacetHit{
+				{Value: "Tragedy", Count: 3},
+			},
+		},
+		{
+			name: "TestIndexFacetSearchWithQueryAndFilter",
+			args: args{
+				client:     defaultClient,
+				facetName:  "tag",
+				facetQuery: "epic",
+				request: &FacetSearchRequest{
+					Query:  "the",
+					Filter: filter.Gt("year", 1900),
+				},
+			},
+			want: []FacetHit{
+				{Value: "Epic fantasy", Count: 3},
+			},
+		},
+		{
+			name: "TestIndexFacetSearchWithoutFacetQuery",
+			args: args{
+				client:    defaultClient,
+				facetName: "tag",
+				request: &FacetSearchRequest{
+					Filter: "year < 1600",
+				},
+			},
+			want: []FacetHit{
+				{Value: "Epic", Count: 2},
+				{Value: "Tragedy", Count: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index("indexUID")
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateFilterableAttributes(&[]string{"tag", "year"})
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			got, err := i.FacetSearch(tt.args.facetName, tt.args.facetQuery, tt.args.request)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.want, got.FacetHits)
+			require.Equal(t, tt.args.facetQuery, got.FacetQuery)
+		})
+	}
+}
+
+func TestIndex_FacetSearchWithNotFilterableFacet(t *testing.T) {
+	SetUpIndexForFaceting()
+	c := defaultClient
+	i := c.Index("indexUID")
+	t.Cleanup(cleanup(c))
+
+	got, err := i.FacetSearch("title", "the", nil)
+	require.Error(t, err)
+	require.Nil(t, got)
+	require.Equal(t, "invalid_facet_search_facet_name", err.(*Error).MeilisearchApiError.Code)
+}
+
 func TestIndex_SearchWithFilters(t *testing.T) {
 	type args struct {
 		UID                  string
@@ -640,6 +1125,34 @@ func TestIndex_SearchWithFilters(t *testing.T) {
 				Limit:              20,
 			},
 		},
+		{
+			name: "TestIndexSearchWithFilterExpression",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				query:  "and",
+				filterableAttributes: []string{
+					"year",
+					"tag",
+				},
+				request: SearchRequest{
+					Filter: filter.And(
+						filter.Eq("tag", "Epic fantasy"),
+						filter.Or(filter.Between("year", 2000, 2010), filter.Lt("year", 1800)),
+					),
+				},
+			},
+			want: &SearchResponse{
+				Hits: []interface{}{
+					map[string]interface{}{
+						"book_id": float64(4), "title": "Harry Potter and the Half-Blood Prince",
+					},
+				},
+				EstimatedTotalHits: 1,
+				Offset:             0,
+				Limit:              20,
+			},
+		},
 		{
 			name: "TestIndexSearchWithFilterMultipleArray",
 			args: args{
@@ -860,6 +1373,92 @@ func TestIndex_SearchWithFilters(t *testing.T) {
 	}
 }
 
+func TestIndex_SearchWithEscapedFilterValues(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression filter.Expression
+		wantID     float64
+	}{
+		{
+			name:       "TestIndexSearchWithBackslashInFilterValue",
+			expression: filter.Eq("path", `C:\dir`),
+			wantID:     1,
+		},
+		{
+			name:       "TestIndexSearchWithDoubleQuoteInFilterValue",
+			expression: filter.Eq("path", `say "hi"`),
+			wantID:     2,
+		},
+	}
+	c := defaultClient
+	i := c.Index("indexUID")
+	t.Cleanup(cleanup(c))
+
+	task, err := i.AddDocuments([]map[string]interface{}{
+		{"id": 1, "path": `C:\dir`},
+		{"id": 2, "path": `say "hi"`},
+		{"id": 3, "path": `C:\\dir`},
+	})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+	task, err = i.UpdateFilterableAttributes(&[]string{"path"})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := i.Search("", &SearchRequest{Filter: tt.expression})
+			require.NoError(t, err)
+			require.Len(t, got.Hits, 1)
+			require.Equal(t, tt.wantID, got.Hits[0].(map[string]interface{})["id"])
+		})
+	}
+}
+
+func TestIndex_ValidateFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		wantErr error
+	}{
+		{
+			name:   "TestIndexValidateFilter",
+			filter: "tag = 'Epic fantasy' AND year 2000 TO 2010",
+		},
+		{
+			name:    "TestIndexValidateFilterWithSyntaxError",
+			filter:  "tag = 'Epic fantasy' AND",
+			wantErr: &filter.SyntaxError{Position: 24, Message: "expected an attribute, `(` or NOT, found end of filter"},
+		},
+		{
+			name:    "TestIndexValidateFilterWithAttributeNotFilterable",
+			filter:  "tag = 'Epic fantasy' AND title = Hamlet",
+			wantErr: &filter.AttributeError{Attribute: "title", Position: 25},
+		},
+	}
+	c := defaultClient
+	i := c.Index("indexUID")
+	t.Cleanup(cleanup(c))
+	SetUpIndexForFaceting()
+
+	task, err := i.UpdateFilterableAttributes(&[]string{"tag", "year"})
+	require.NoError(t, err)
+	testWaitForTask(t, i, task)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := i.ValidateFilter(tt.filter)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				_, err = i.Search("", &SearchRequest{Filter: tt.filter})
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, tt.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestIndex_SearchWithSort(t *testing.T) {
 	type args struct {
 		UID                string
@@ -0,0 +1,116 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// SearchHit is a search hit decoded into the document type T, along with the
+// fields Meilisearch adds to the hit depending on the SearchRequest.
+type SearchHit[T any] struct {
+	// Document is the hit itself
+	Document T
+
+	// Formatted is the `_formatted` field, set when attributes are highlighted or cropped.
+	// It is not decoded into T since Meilisearch turns the numbers it contains into strings.
+	Formatted map[string]interface{}
+
+	// MatchesPosition is the `_matchesPosition` field, set with SearchRequest.ShowMatchesPosition
+	MatchesPosition map[string][]MatchPosition
+
+	// RankingScore is the `_rankingScore` field, between 0 and 1, set with SearchRequest.ShowRankingScore
+	RankingScore float64
+
+	// RankingScoreDetails is the `_rankingScoreDetails` field, set with SearchRequest.ShowRankingScoreDetails
+	RankingScoreDetails map[string]RankingScoreDetail
+	// Vectors is the `_vectors` field, set with SearchRequest.RetrieveVectors
+	Vectors map[string]EmbedderVectors
+}
+
+// UnmarshalJSON decodes the hit into Document, then decodes it a second time into
+// the fields Meilisearch adds to the hit. The second pass only keeps these fields,
+// the other fields of the hit are skipped.
+func (h *SearchHit[T]) UnmarshalJSON(data []byte) error {
+	var document T
+	if err := json.Unmarshal(data, &document); err != nil {
+		return err
+	}
+	var fields struct {
+		Formatted           map[string]interface{}        `json:"_formatted"`
+		MatchesPosition     map[string][]MatchPosition    `json:"_matchesPosition"`
+		RankingScore        float64                       `json:"_rankingScore"`
+		RankingScoreDetails map[string]RankingScoreDetail `json:"_rankingScoreDetails"`
+		Vectors             map[string]EmbedderVectors    `json:"_vectors"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*h = SearchHit[T]{
+		Document:            document,
+		Formatted:           fields.Formatted,
+		MatchesPosition:     fields.MatchesPosition,
+		RankingScore:        fields.RankingScore,
+		RankingScoreDetails: fields.RankingScoreDetails,
+		Vectors:             fields.Vectors,
+	}
+	return nil
+}
+
+// TypedSearchResponse is the response body for search method with hits decoded into T
+type TypedSearchResponse[T any] struct {
+	Hits               []SearchHit[T]              `json:"hits"`
+	EstimatedTotalHits int64                       `json:"estimatedTotalHits,omitempty"`
+	Offset             int64                       `json:"offset,omitempty"`
+	Limit              int64                       `json:"limit,omitempty"`
+	ProcessingTimeMs   int64                       `json:"processingTimeMs"`
+	Query              string                      `json:"query"`
+	FacetDistribution  map[string]map[string]int64 `json:"facetDistribution,omitempty"`
+	FacetStats         map[string]FacetStat        `json:"facetStats,omitempty"`
+	TotalHits          int64                       `json:"totalHits,omitempty"`
+	HitsPerPage        int64                       `json:"hitsPerPage,omitempty"`
+	Page               int64                       `json:"page,omitempty"`
+	TotalPages         int64                       `json:"totalPages,omitempty"`
+	SemanticHitCount   int64                       `json:"semanticHitCount,omitempty"`
+}
+
+// Documents returns the documents of the hits, in the order of the hits
+func (r *TypedSearchResponse[T]) Documents() []T {
+	documents := make([]T, len(r.Hits))
+	for i, hit := range r.Hits {
+		documents[i] = hit.Document
+	}
+	return documents
+}
+
+// SearchTyped searches the index like Index.Search, decoding each hit directly into T
+func SearchTyped[T any](index *Index, query string, request *SearchRequest) (*TypedSearchResponse[T], error) {
+	return SearchTypedWithContext[T](context.Background(), index, query, request)
+}
+
+func SearchTypedWithContext[T any](ctx context.Context, index *Index, query string, request *SearchRequest) (*TypedSearchResponse[T], error) {
+	resp := &TypedSearchResponse[T]{}
+
+	if request.Limit == 0 {
+		request.Limit = DefaultLimit
+	}
+
+	searchPostRequestParams := searchPostRequestParams(query, request)
+
+	req := internalRequest{
+		endpoint:            "/indexes/" + index.UID + "/search",
+		method:              http.MethodPost,
+		contentType:         contentTypeJSON,
+		withRequest:         searchPostRequestParams,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "SearchTyped",
+		readOnly:            true,
+	}
+
+	if err := index.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,134 @@
+package meilisearch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndex_SearchTyped(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		query   string
+		request SearchRequest
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          []docTestBooks
+		wantFormatted []string
+		wantMatches   bool
+	}{
+		{
+			name: "TestIndexSearchTyped",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				query:   "prince",
+				request: SearchRequest{},
+			},
+			want: []docTestBooks{
+				{BookID: 456, Title: "Le Petit Prince", Tag: "Tale", Year: 1943},
+				{BookID: 4, Title: "Harry Potter and the Half-Blood Prince", Tag: "Epic fantasy", Year: 2005},
+			},
+		},
+		{
+			name: "TestIndexSearchTypedWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+				query:  "prince",
+				request: SearchRequest{
+					Limit: 1,
+				},
+			},
+			want: []docTestBooks{
+				{BookID: 456, Title: "Le Petit Prince", Tag: "Tale", Year: 1943},
+			},
+		},
+		{
+			name: "TestIndexSearchTypedWithHighlightAndMatchesPosition",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				query:  "prince",
+				request: SearchRequest{
+					AttributesToHighlight: []string{"title"},
+					ShowMatchesPosition:   true,
+				},
+			},
+			want: []docTestBooks{
+				{BookID: 456, Title: "Le Petit Prince", Tag: "Tale", Year: 1943},
+				{BookID: 4, Title: "Harry Potter and the Half-Blood Prince", Tag: "Epic fantasy", Year: 2005},
+			},
+			wantFormatted: []string{
+				"Le Petit <em>Prince</em>",
+				"Harry Potter and the Half-Blood <em>Prince</em>",
+			},
+			wantMatches: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			got, err := SearchTyped[docTestBooks](i, tt.args.query, &tt.args.request)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Documents())
+			for j, hit := range got.Hits {
+				if tt.wantFormatted != nil {
+					require.NotNil(t, hit.Formatted)
+					require.Equal(t, tt.wantFormatted[j], hit.Formatted["title"])
+				} else {
+					require.Nil(t, hit.Formatted)
+				}
+				if tt.wantMatches {
+					require.NotEmpty(t, hit.MatchesPosition["title"])
+				} else {
+					require.Nil(t, hit.MatchesPosition)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchHit_UnmarshalJSON(t *testing.T) {
+	var got []SearchHit[docTestBooks]
+	err := json.Unmarshal([]byte(`[
+		{
+			"book_id": 456,
+			"title": "Le Petit Prince",
+			"_formatted": {"book_id": "456", "title": "Le Petit <em>Prince</em>"},
+			"_matchesPosition": {"title": [{"start": 9, "length": 6}]},
+			"_rankingScore": 0.75,
+			"_rankingScoreDetails": {"words": {"order": 0, "matchingWords": 1, "maxMatchingWords": 1, "score": 1.0}},
+			"_vectors": {"default": {"embeddings": [[0.5, 0.25]], "regenerate": false}}
+		},
+		{"book_id": 4, "title": "Harry Potter"}
+	]`), &got)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	require.Equal(t, docTestBooks{BookID: 456, Title: "Le Petit Prince"}, got[0].Document)
+	require.Equal(t, "Le Petit <em>Prince</em>", got[0].Formatted["title"])
+	require.Equal(t, []MatchPosition{{Start: 9, Length: 6}}, got[0].MatchesPosition["title"])
+	require.Equal(t, 0.75, got[0].RankingScore)
+	require.Equal(t, map[string]RankingScoreDetail{
+		"words": {Order: 0, MatchingWords: 1, MaxMatchingWords: 1, Score: 1},
+	}, got[0].RankingScoreDetails)
+	require.Equal(t, map[string]EmbedderVectors{
+		"default": {Embeddings: [][]float32{{0.5, 0.25}}},
+	}, got[0].Vectors)
+
+	require.Equal(t, docTestBooks{BookID: 4, Title: "Harry Potter"}, got[1].Document)
+	require.Nil(t, got[1].Formatted)
+	require.Nil(t, got[1].MatchesPosition)
+	require.Zero(t, got[1].RankingScore)
+	require.Nil(t, got[1].RankingScoreDetails)
+	require.Nil(t, got[1].Vectors)
+}
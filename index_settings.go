@@ -1,10 +1,15 @@
 package meilisearch
 
 import (
+	"context"
 	"net/http"
 )
 
 func (i Index) GetSettings() (resp *Settings, err error) {
+	return i.GetSettingsWithContext(context.Background())
+}
+
+func (i Index) GetSettingsWithContext(ctx context.Context) (resp *Settings, err error) {
 	resp = &Settings{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings",
@@ -14,13 +19,17 @@ func (i Index) GetSettings() (resp *Settings, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetSettings",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateSettings(request *Settings) (resp *TaskInfo, err error) {
+	return i.UpdateSettingsWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateSettingsWithContext(ctx context.Context, request *Settings) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings",
@@ -31,13 +40,17 @@ func (i Index) UpdateSettings(request *Settings) (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateSettings",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetSettings() (resp *TaskInfo, err error) {
+	return i.ResetSettingsWithContext(context.Background())
+}
+
+func (i Index) ResetSettingsWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings",
@@ -47,13 +60,17 @@ func (i Index) ResetSettings() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetSettings",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetRankingRules() (resp *[]string, err error) {
+	return i.GetRankingRulesWithContext(context.Background())
+}
+
+func (i Index) GetRankingRulesWithContext(ctx context.Context) (resp *[]string, err error) {
 	resp = &[]string{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/ranking-rules",
@@ -63,13 +80,17 @@ func (i Index) GetRankingRules() (resp *[]string, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetRankingRules",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateRankingRules(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateRankingRulesWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateRankingRulesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/ranking-rules",
@@ -80,13 +101,17 @@ func (i Index) UpdateRankingRules(request *[]string) (resp *TaskInfo, err error)
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateRankingRules",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetRankingRules() (resp *TaskInfo, err error) {
+	return i.ResetRankingRulesWithContext(context.Background())
+}
+
+func (i Index) ResetRankingRulesWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/ranking-rules",
@@ -96,13 +121,17 @@ func (i Index) ResetRankingRules() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetRankingRules",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetDistinctAttribute() (resp *string, err error) {
+	return i.GetDistinctAttributeWithContext(context.Background())
+}
+
+func (i Index) GetDistinctAttributeWithContext(ctx context.Context) (resp *string, err error) {
 	empty := ""
 	resp = &empty
 	req := internalRequest{
@@ -113,13 +142,17 @@ func (i Index) GetDistinctAttribute() (resp *string, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetDistinctAttribute",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateDistinctAttribute(request string) (resp *TaskInfo, err error) {
+	return i.UpdateDistinctAttributeWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateDistinctAttributeWithContext(ctx context.Context, request string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/distinct-attribute",
@@ -130,13 +163,17 @@ func (i Index) UpdateDistinctAttribute(request string) (resp *TaskInfo, err erro
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateDistinctAttribute",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetDistinctAttribute() (resp *TaskInfo, err error) {
+	return i.ResetDistinctAttributeWithContext(context.Background())
+}
+
+func (i Index) ResetDistinctAttributeWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/distinct-attribute",
@@ -146,13 +183,17 @@ func (i Index) ResetDistinctAttribute() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetDistinctAttribute",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetSearchableAttributes() (resp *[]string, err error) {
+	return i.GetSearchableAttributesWithContext(context.Background())
+}
+
+func (i Index) GetSearchableAttributesWithContext(ctx context.Context) (resp *[]string, err error) {
 	resp = &[]string{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/searchable-attributes",
@@ -162,13 +203,17 @@ func (i Index) GetSearchableAttributes() (resp *[]string, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetSearchableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateSearchableAttributes(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateSearchableAttributesWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateSearchableAttributesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/searchable-attributes",
@@ -179,13 +224,17 @@ func (i Index) UpdateSearchableAttributes(request *[]string) (resp *TaskInfo, er
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateSearchableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetSearchableAttributes() (resp *TaskInfo, err error) {
+	return i.ResetSearchableAttributesWithContext(context.Background())
+}
+
+func (i Index) ResetSearchableAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/searchable-attributes",
@@ -195,13 +244,17 @@ func (i Index) ResetSearchableAttributes() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetSearchableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetDisplayedAttributes() (resp *[]string, err error) {
+	return i.GetDisplayedAttributesWithContext(context.Background())
+}
+
+func (i Index) GetDisplayedAttributesWithContext(ctx context.Context) (resp *[]string, err error) {
 	resp = &[]string{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/displayed-attributes",
@@ -211,13 +264,17 @@ func (i Index) GetDisplayedAttributes() (resp *[]string, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetDisplayedAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateDisplayedAttributes(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateDisplayedAttributesWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateDisplayedAttributesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/displayed-attributes",
@@ -228,13 +285,17 @@ func (i Index) UpdateDisplayedAttributes(request *[]string) (resp *TaskInfo, err
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateDisplayedAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetDisplayedAttributes() (resp *TaskInfo, err error) {
+	return i.ResetDisplayedAttributesWithContext(context.Background())
+}
+
+func (i Index) ResetDisplayedAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/displayed-attributes",
@@ -244,13 +305,17 @@ func (i Index) ResetDisplayedAttributes() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetDisplayedAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetStopWords() (resp *[]string, err error) {
+	return i.GetStopWordsWithContext(context.Background())
+}
+
+func (i Index) GetStopWordsWithContext(ctx context.Context) (resp *[]string, err error) {
 	resp = &[]string{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/stop-words",
@@ -260,13 +325,17 @@ func (i Index) GetStopWords() (resp *[]string, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetStopWords",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateStopWords(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateStopWordsWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateStopWordsWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/stop-words",
@@ -277,13 +346,17 @@ func (i Index) UpdateStopWords(request *[]string) (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateStopWords",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetStopWords() (resp *TaskInfo, err error) {
+	return i.ResetStopWordsWithContext(context.Background())
+}
+
+func (i Index) ResetStopWordsWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/stop-words",
@@ -293,13 +366,17 @@ func (i Index) ResetStopWords() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetStopWords",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetSynonyms() (resp *map[string][]string, err error) {
+	return i.GetSynonymsWithContext(context.Background())
+}
+
+func (i Index) GetSynonymsWithContext(ctx context.Context) (resp *map[string][]string, err error) {
 	resp = &map[string][]string{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/synonyms",
@@ -309,13 +386,17 @@ func (i Index) GetSynonyms() (resp *map[string][]string, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetSynonyms",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateSynonyms(request *map[string][]string) (resp *TaskInfo, err error) {
+	return i.UpdateSynonymsWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateSynonymsWithContext(ctx context.Context, request *map[string][]string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/synonyms",
@@ -326,13 +407,17 @@ func (i Index) UpdateSynonyms(request *map[string][]string) (resp *TaskInfo, err
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateSynonyms",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetSynonyms() (resp *TaskInfo, err error) {
+	return i.ResetSynonymsWithContext(context.Background())
+}
+
+func (i Index) ResetSynonymsWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/synonyms",
@@ -342,13 +427,17 @@ func (i Index) ResetSynonyms() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetSynonyms",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetFilterableAttributes() (resp *[]string, err error) {
+	return i.GetFilterableAttributesWithContext(context.Background())
+}
+
+func (i Index) GetFilterableAttributesWithContext(ctx context.Context) (resp *[]string, err error) {
 	resp = &[]string{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/filterable-attributes",
@@ -358,13 +447,17 @@ func (i Index) GetFilterableAttributes() (resp *[]string, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetFilterableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateFilterableAttributes(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateFilterableAttributesWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateFilterableAttributesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/filterable-attributes",
@@ -375,13 +468,17 @@ func (i Index) UpdateFilterableAttributes(request *[]string) (resp *TaskInfo, er
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateFilterableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetFilterableAttributes() (resp *TaskInfo, err error) {
+	return i.ResetFilterableAttributesWithContext(context.Background())
+}
+
+func (i Index) ResetFilterableAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/filterable-attributes",
@@ -391,13 +488,17 @@ func (i Index) ResetFilterableAttributes() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetFilterableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetSortableAttributes() (resp *[]string, err error) {
+	return i.GetSortableAttributesWithContext(context.Background())
+}
+
+func (i Index) GetSortableAttributesWithContext(ctx context.Context) (resp *[]string, err error) {
 	resp = &[]string{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/sortable-attributes",
@@ -407,13 +508,17 @@ func (i Index) GetSortableAttributes() (resp *[]string, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetSortableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateSortableAttributes(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateSortableAttributesWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateSortableAttributesWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/sortable-attributes",
@@ -424,13 +529,17 @@ func (i Index) UpdateSortableAttributes(request *[]string) (resp *TaskInfo, err
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateSortableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetSortableAttributes() (resp *TaskInfo, err error) {
+	return i.ResetSortableAttributesWithContext(context.Background())
+}
+
+func (i Index) ResetSortableAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/sortable-attributes",
@@ -440,13 +549,17 @@ func (i Index) ResetSortableAttributes() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetSortableAttributes",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetTypoTolerance() (resp *TypoTolerance, err error) {
+	return i.GetTypoToleranceWithContext(context.Background())
+}
+
+func (i Index) GetTypoToleranceWithContext(ctx context.Context) (resp *TypoTolerance, err error) {
 	resp = &TypoTolerance{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/typo-tolerance",
@@ -456,13 +569,17 @@ func (i Index) GetTypoTolerance() (resp *TypoTolerance, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetTypoTolerance",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateTypoTolerance(request *TypoTolerance) (resp *TaskInfo, err error) {
+	return i.UpdateTypoToleranceWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateTypoToleranceWithContext(ctx context.Context, request *TypoTolerance) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/typo-tolerance",
@@ -473,13 +590,17 @@ func (i Index) UpdateTypoTolerance(request *TypoTolerance) (resp *TaskInfo, err
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateTypoTolerance",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetTypoTolerance() (resp *TaskInfo, err error) {
+	return i.ResetTypoToleranceWithContext(context.Background())
+}
+
+func (i Index) ResetTypoToleranceWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/typo-tolerance",
@@ -489,13 +610,17 @@ func (i Index) ResetTypoTolerance() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetTypoTolerance",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetPagination() (resp *Pagination, err error) {
+	return i.GetPaginationWithContext(context.Background())
+}
+
+func (i Index) GetPaginationWithContext(ctx context.Context) (resp *Pagination, err error) {
 	resp = &Pagination{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/pagination",
@@ -505,13 +630,17 @@ func (i Index) GetPagination() (resp *Pagination, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetPagination",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdatePagination(request *Pagination) (resp *TaskInfo, err error) {
+	return i.UpdatePaginationWithContext(context.Background(), request)
+}
+
+func (i Index) UpdatePaginationWithContext(ctx context.Context, request *Pagination) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/pagination",
@@ -522,13 +651,17 @@ func (i Index) UpdatePagination(request *Pagination) (resp *TaskInfo, err error)
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdatePagination",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetPagination() (resp *TaskInfo, err error) {
+	return i.ResetPaginationWithContext(context.Background())
+}
+
+func (i Index) ResetPaginationWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/pagination",
@@ -538,13 +671,17 @@ func (i Index) ResetPagination() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetPagination",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) GetFaceting() (resp *Faceting, err error) {
+	return i.GetFacetingWithContext(context.Background())
+}
+
+func (i Index) GetFacetingWithContext(ctx context.Context) (resp *Faceting, err error) {
 	resp = &Faceting{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/faceting",
@@ -554,13 +691,17 @@ func (i Index) GetFaceting() (resp *Faceting, err error) {
 		acceptedStatusCodes: []int{http.StatusOK},
 		functionName:        "GetFaceting",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) UpdateFaceting(request *Faceting) (resp *TaskInfo, err error) {
+	return i.UpdateFacetingWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateFacetingWithContext(ctx context.Context, request *Faceting) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/faceting",
@@ -571,13 +712,17 @@ func (i Index) UpdateFaceting(request *Faceting) (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "UpdateFaceting",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
 func (i Index) ResetFaceting() (resp *TaskInfo, err error) {
+	return i.ResetFacetingWithContext(context.Background())
+}
+
+func (i Index) ResetFacetingWithContext(ctx context.Context) (resp *TaskInfo, err error) {
 	resp = &TaskInfo{}
 	req := internalRequest{
 		endpoint:            "/indexes/" + i.UID + "/settings/faceting",
@@ -587,7 +732,558 @@ func (i Index) ResetFaceting() (resp *TaskInfo, err error) {
 		acceptedStatusCodes: []int{http.StatusAccepted},
 		functionName:        "ResetFaceting",
 	}
-	if err := i.client.executeRequest(req); err != nil {
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetEmbedders() (resp *map[string]Embedder, err error) {
+	return i.GetEmbeddersWithContext(context.Background())
+}
+
+func (i Index) GetEmbeddersWithContext(ctx context.Context) (resp *map[string]Embedder, err error) {
+	resp = &map[string]Embedder{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/embedders",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetEmbedders",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateEmbedders adds or updates the embedders of the request, the other embedders are kept.
+// Use ResetEmbedders to remove all the embedders.
+func (i Index) UpdateEmbedders(request *map[string]Embedder) (resp *TaskInfo, err error) {
+	return i.UpdateEmbeddersWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateEmbeddersWithContext(ctx context.Context, request *map[string]Embedder) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/embedders",
+		method:              http.MethodPatch,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateEmbedders",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetEmbedders() (resp *TaskInfo, err error) {
+	return i.ResetEmbeddersWithContext(context.Background())
+}
+
+func (i Index) ResetEmbeddersWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/embedders",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetEmbedders",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetProximityPrecision() (resp *ProximityPrecision, err error) {
+	return i.GetProximityPrecisionWithContext(context.Background())
+}
+
+func (i Index) GetProximityPrecisionWithContext(ctx context.Context) (resp *ProximityPrecision, err error) {
+	resp = new(ProximityPrecision)
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/proximity-precision",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetProximityPrecision",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) UpdateProximityPrecision(request ProximityPrecision) (resp *TaskInfo, err error) {
+	return i.UpdateProximityPrecisionWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateProximityPrecisionWithContext(ctx context.Context, request ProximityPrecision) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/proximity-precision",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateProximityPrecision",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetProximityPrecision() (resp *TaskInfo, err error) {
+	return i.ResetProximityPrecisionWithContext(context.Background())
+}
+
+func (i Index) ResetProximityPrecisionWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/proximity-precision",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetProximityPrecision",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetSeparatorTokens() (resp *[]string, err error) {
+	return i.GetSeparatorTokensWithContext(context.Background())
+}
+
+func (i Index) GetSeparatorTokensWithContext(ctx context.Context) (resp *[]string, err error) {
+	resp = &[]string{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/separator-tokens",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetSeparatorTokens",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) UpdateSeparatorTokens(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateSeparatorTokensWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateSeparatorTokensWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/separator-tokens",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateSeparatorTokens",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetSeparatorTokens() (resp *TaskInfo, err error) {
+	return i.ResetSeparatorTokensWithContext(context.Background())
+}
+
+func (i Index) ResetSeparatorTokensWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/separator-tokens",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetSeparatorTokens",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetNonSeparatorTokens() (resp *[]string, err error) {
+	return i.GetNonSeparatorTokensWithContext(context.Background())
+}
+
+func (i Index) GetNonSeparatorTokensWithContext(ctx context.Context) (resp *[]string, err error) {
+	resp = &[]string{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/non-separator-tokens",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetNonSeparatorTokens",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) UpdateNonSeparatorTokens(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateNonSeparatorTokensWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateNonSeparatorTokensWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/non-separator-tokens",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateNonSeparatorTokens",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetNonSeparatorTokens() (resp *TaskInfo, err error) {
+	return i.ResetNonSeparatorTokensWithContext(context.Background())
+}
+
+func (i Index) ResetNonSeparatorTokensWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/non-separator-tokens",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetNonSeparatorTokens",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetDictionary() (resp *[]string, err error) {
+	return i.GetDictionaryWithContext(context.Background())
+}
+
+func (i Index) GetDictionaryWithContext(ctx context.Context) (resp *[]string, err error) {
+	resp = &[]string{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/dictionary",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetDictionary",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) UpdateDictionary(request *[]string) (resp *TaskInfo, err error) {
+	return i.UpdateDictionaryWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateDictionaryWithContext(ctx context.Context, request *[]string) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/dictionary",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateDictionary",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetDictionary() (resp *TaskInfo, err error) {
+	return i.ResetDictionaryWithContext(context.Background())
+}
+
+func (i Index) ResetDictionaryWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/dictionary",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetDictionary",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetSearchCutoffMs() (resp *int64, err error) {
+	return i.GetSearchCutoffMsWithContext(context.Background())
+}
+
+func (i Index) GetSearchCutoffMsWithContext(ctx context.Context) (resp *int64, err error) {
+	resp = new(int64)
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/search-cutoff-ms",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetSearchCutoffMs",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) UpdateSearchCutoffMs(request int64) (resp *TaskInfo, err error) {
+	return i.UpdateSearchCutoffMsWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateSearchCutoffMsWithContext(ctx context.Context, request int64) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/search-cutoff-ms",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateSearchCutoffMs",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetSearchCutoffMs() (resp *TaskInfo, err error) {
+	return i.ResetSearchCutoffMsWithContext(context.Background())
+}
+
+func (i Index) ResetSearchCutoffMsWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/search-cutoff-ms",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetSearchCutoffMs",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetLocalizedAttributes() (resp *[]LocalizedAttributes, err error) {
+	return i.GetLocalizedAttributesWithContext(context.Background())
+}
+
+func (i Index) GetLocalizedAttributesWithContext(ctx context.Context) (resp *[]LocalizedAttributes, err error) {
+	resp = &[]LocalizedAttributes{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/localized-attributes",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetLocalizedAttributes",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) UpdateLocalizedAttributes(request *[]LocalizedAttributes) (resp *TaskInfo, err error) {
+	return i.UpdateLocalizedAttributesWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateLocalizedAttributesWithContext(ctx context.Context, request *[]LocalizedAttributes) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/localized-attributes",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateLocalizedAttributes",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetLocalizedAttributes() (resp *TaskInfo, err error) {
+	return i.ResetLocalizedAttributesWithContext(context.Background())
+}
+
+func (i Index) ResetLocalizedAttributesWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/localized-attributes",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetLocalizedAttributes",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetFacetSearch() (resp *bool, err error) {
+	return i.GetFacetSearchWithContext(context.Background())
+}
+
+func (i Index) GetFacetSearchWithContext(ctx context.Context) (resp *bool, err error) {
+	resp = new(bool)
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/facet-search",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetFacetSearch",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) UpdateFacetSearch(request bool) (resp *TaskInfo, err error) {
+	return i.UpdateFacetSearchWithContext(context.Background(), request)
+}
+
+func (i Index) UpdateFacetSearchWithContext(ctx context.Context, request bool) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/facet-search",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdateFacetSearch",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetFacetSearch() (resp *TaskInfo, err error) {
+	return i.ResetFacetSearchWithContext(context.Background())
+}
+
+func (i Index) ResetFacetSearchWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/facet-search",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetFacetSearch",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) GetPrefixSearch() (resp *PrefixSearch, err error) {
+	return i.GetPrefixSearchWithContext(context.Background())
+}
+
+func (i Index) GetPrefixSearchWithContext(ctx context.Context) (resp *PrefixSearch, err error) {
+	resp = new(PrefixSearch)
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/prefix-search",
+		method:              http.MethodGet,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusOK},
+		functionName:        "GetPrefixSearch",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) UpdatePrefixSearch(request PrefixSearch) (resp *TaskInfo, err error) {
+	return i.UpdatePrefixSearchWithContext(context.Background(), request)
+}
+
+func (i Index) UpdatePrefixSearchWithContext(ctx context.Context, request PrefixSearch) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/prefix-search",
+		method:              http.MethodPut,
+		contentType:         contentTypeJSON,
+		withRequest:         &request,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "UpdatePrefixSearch",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (i Index) ResetPrefixSearch() (resp *TaskInfo, err error) {
+	return i.ResetPrefixSearchWithContext(context.Background())
+}
+
+func (i Index) ResetPrefixSearchWithContext(ctx context.Context) (resp *TaskInfo, err error) {
+	resp = &TaskInfo{}
+	req := internalRequest{
+		endpoint:            "/indexes/" + i.UID + "/settings/prefix-search",
+		method:              http.MethodDelete,
+		withRequest:         nil,
+		withResponse:        resp,
+		acceptedStatusCodes: []int{http.StatusAccepted},
+		functionName:        "ResetPrefixSearch",
+	}
+	if err := i.client.executeRequest(ctx, req); err != nil {
 		return nil, err
 	}
 	return resp, nil
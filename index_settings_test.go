@@ -1,6 +1,7 @@
 package meilisearch
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -233,6 +234,13 @@ func TestIndex_GetSettings(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -253,6 +261,13 @@ func TestIndex_GetSettings(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 	}
@@ -798,6 +813,13 @@ func TestIndex_ResetSettings(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -821,6 +843,13 @@ func TestIndex_ResetSettings(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 	}
@@ -1477,6 +1506,8 @@ func TestIndex_UpdateSearchableAttributes(t *testing.T) {
 }
 
 func TestIndex_UpdateSettings(t *testing.T) {
+	facetSearchDisabled := false
+
 	type args struct {
 		UID     string
 		client  *Client
@@ -1531,6 +1562,19 @@ func TestIndex_UpdateSettings(t *testing.T) {
 					Faceting: &Faceting{
 						MaxValuesPerFacet: 200,
 					},
+					Embedders: map[string]Embedder{
+						"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+					},
+					ProximityPrecision: ProximityPrecisionByAttribute,
+					SeparatorTokens:    []string{"&"},
+					NonSeparatorTokens: []string{"@"},
+					Dictionary:         []string{"J. R. R."},
+					SearchCutoffMs:     150,
+					LocalizedAttributes: []LocalizedAttributes{
+						{AttributePatterns: []string{"title"}, Locales: []string{"eng"}},
+					},
+					FacetSearch:  &facetSearchDisabled,
+					PrefixSearch: PrefixSearchDisabled,
 				},
 			},
 			wantTask: &TaskInfo{
@@ -1548,6 +1592,13 @@ func TestIndex_UpdateSettings(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -1593,6 +1644,19 @@ func TestIndex_UpdateSettings(t *testing.T) {
 					Faceting: &Faceting{
 						MaxValuesPerFacet: 200,
 					},
+					Embedders: map[string]Embedder{
+						"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+					},
+					ProximityPrecision: ProximityPrecisionByAttribute,
+					SeparatorTokens:    []string{"&"},
+					NonSeparatorTokens: []string{"@"},
+					Dictionary:         []string{"J. R. R."},
+					SearchCutoffMs:     150,
+					LocalizedAttributes: []LocalizedAttributes{
+						{AttributePatterns: []string{"title"}, Locales: []string{"eng"}},
+					},
+					FacetSearch:  &facetSearchDisabled,
+					PrefixSearch: PrefixSearchDisabled,
 				},
 			},
 			wantTask: &TaskInfo{
@@ -1610,6 +1674,13 @@ func TestIndex_UpdateSettings(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 	}
@@ -1680,6 +1751,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 				secondRequest: Settings{
 					Synonyms: map[string][]string{
@@ -1702,6 +1780,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 			},
 			wantTask: &TaskInfo{
@@ -1719,6 +1804,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -1750,6 +1842,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 				secondRequest: Settings{
 					Synonyms: map[string][]string{
@@ -1772,6 +1871,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 			},
 			wantTask: &TaskInfo{
@@ -1789,6 +1895,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -1820,6 +1933,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 				secondRequest: Settings{
 					SearchableAttributes: []string{
@@ -1842,6 +1962,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 			},
 			wantTask: &TaskInfo{
@@ -1859,6 +1986,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -1890,6 +2024,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 				secondRequest: Settings{
 					DisplayedAttributes: []string{
@@ -1912,6 +2053,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 			},
 			wantTask: &TaskInfo{
@@ -1929,6 +2077,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -1960,6 +2115,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 				secondRequest: Settings{
 					StopWords: []string{
@@ -1982,6 +2144,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					TypoTolerance:        &defaultTypoTolerance,
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 			},
 			wantTask: &TaskInfo{
@@ -1999,6 +2168,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -2069,6 +2245,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -2139,6 +2322,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -2182,6 +2372,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					SortableAttributes:   []string{},
 					Pagination:           &defaultPagination,
 					Faceting:             &defaultFaceting,
+					Embedders:            map[string]Embedder{},
+					ProximityPrecision:   ProximityPrecisionByWord,
+					SeparatorTokens:      []string{},
+					NonSeparatorTokens:   []string{},
+					Dictionary:           []string{},
+					FacetSearch:          &defaultFacetSearch,
+					PrefixSearch:         PrefixSearchIndexingTime,
 				},
 				secondRequest: Settings{
 					TypoTolerance: &TypoTolerance{
@@ -2241,6 +2438,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -2311,6 +2515,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 		{
@@ -2342,6 +2553,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					Faceting: &Faceting{
 						MaxValuesPerFacet: 200,
 					},
+					Embedders:          map[string]Embedder{},
+					ProximityPrecision: ProximityPrecisionByWord,
+					SeparatorTokens:    []string{},
+					NonSeparatorTokens: []string{},
+					Dictionary:         []string{},
+					FacetSearch:        &defaultFacetSearch,
+					PrefixSearch:       PrefixSearchIndexingTime,
 				},
 				secondRequest: Settings{
 					Faceting: &Faceting{
@@ -2364,6 +2582,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 					Faceting: &Faceting{
 						MaxValuesPerFacet: 200,
 					},
+					Embedders:          map[string]Embedder{},
+					ProximityPrecision: ProximityPrecisionByWord,
+					SeparatorTokens:    []string{},
+					NonSeparatorTokens: []string{},
+					Dictionary:         []string{},
+					FacetSearch:        &defaultFacetSearch,
+					PrefixSearch:       PrefixSearchIndexingTime,
 				},
 			},
 			wantTask: &TaskInfo{
@@ -2381,6 +2606,13 @@ func TestIndex_UpdateSettingsOneByOne(t *testing.T) {
 				TypoTolerance:        &defaultTypoTolerance,
 				Pagination:           &defaultPagination,
 				Faceting:             &defaultFaceting,
+				Embedders:            map[string]Embedder{},
+				ProximityPrecision:   ProximityPrecisionByWord,
+				SeparatorTokens:      []string{},
+				NonSeparatorTokens:   []string{},
+				Dictionary:           []string{},
+				FacetSearch:          &defaultFacetSearch,
+				PrefixSearch:         PrefixSearchIndexingTime,
 			},
 		},
 	}
@@ -2847,3 +3079,1467 @@ func TestIndex_UpdateFaceting(t *testing.T) {
 		})
 	}
 }
+
+func TestIndex_GetEmbedders(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp *map[string]Embedder
+	}{
+		{
+			name: "TestIndexBasicGetEmbedders",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: &map[string]Embedder{},
+		},
+		{
+			name: "TestIndexGetEmbeddersWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: &map[string]Embedder{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetEmbedders()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateEmbedders(t *testing.T) {
+	type args struct {
+		UID           string
+		client        *Client
+		firstRequest  map[string]Embedder
+		secondRequest map[string]Embedder
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp *map[string]Embedder
+	}{
+		{
+			name: "TestIndexBasicUpdateEmbedders",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				firstRequest: map[string]Embedder{
+					"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+				},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: &map[string]Embedder{
+				"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+			},
+		},
+		{
+			name: "TestIndexUpdateEmbeddersKeepsOtherEmbedders",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+				firstRequest: map[string]Embedder{
+					"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+				},
+				secondRequest: map[string]Embedder{
+					"small": {
+						Source:       EmbedderSourceUserProvided,
+						Dimensions:   2,
+						Distribution: &EmbedderDistribution{Mean: 0.7, Sigma: 0.3},
+					},
+				},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: &map[string]Embedder{
+				"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+				"small": {
+					Source:       EmbedderSourceUserProvided,
+					Dimensions:   2,
+					Distribution: &EmbedderDistribution{Mean: 0.7, Sigma: 0.3},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			for _, request := range []map[string]Embedder{tt.args.firstRequest, tt.args.secondRequest} {
+				if request == nil {
+					continue
+				}
+				gotTask, err := i.UpdateEmbedders(&request)
+				require.NoError(t, err)
+				require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+				testWaitForTask(t, i, gotTask)
+			}
+
+			gotResp, err := i.GetEmbedders()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetEmbedders(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp *map[string]Embedder
+	}{
+		{
+			name: "TestIndexBasicResetEmbedders",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: &map[string]Embedder{},
+		},
+		{
+			name: "TestIndexResetEmbeddersWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: &map[string]Embedder{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateEmbedders(&map[string]Embedder{
+				"default": {Source: EmbedderSourceUserProvided, Dimensions: 3},
+			})
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetEmbedders()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetEmbedders()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, gotResp)
+		})
+	}
+}
+
+func TestEmbedder_MarshalJSON(t *testing.T) {
+	got, err := json.Marshal(map[string]Embedder{
+		"rest": {
+			Source:           EmbedderSourceREST,
+			URL:              "http://localhost:8080/embed",
+			APIKey:           "key",
+			Dimensions:       512,
+			DocumentTemplate: "A movie titled {{doc.title}}",
+			Request:          map[string]interface{}{"model": "small", "input": []string{"{{text}}", "{{..}}"}},
+			Response:         map[string]interface{}{"data": []interface{}{map[string]interface{}{"embedding": "{{embedding}}"}, "{{..}}"}},
+			Headers:          map[string]string{"X-Custom": "value"},
+		},
+		"openAi": {
+			Source: EmbedderSourceOpenAI,
+			Model:  "text-embedding-3-small",
+			APIKey: "sk-key",
+		},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"rest": {
+			"source": "rest",
+			"url": "http://localhost:8080/embed",
+			"apiKey": "key",
+			"dimensions": 512,
+			"documentTemplate": "A movie titled {{doc.title}}",
+			"request": {"model": "small", "input": ["{{text}}", "{{..}}"]},
+			"response": {"data": [{"embedding": "{{embedding}}"}, "{{..}}"]},
+			"headers": {"X-Custom": "value"}
+		},
+		"openAi": {
+			"source": "openAi",
+			"model": "text-embedding-3-small",
+			"apiKey": "sk-key"
+		}
+	}`, string(got))
+}
+
+func TestIndex_GetProximityPrecision(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp ProximityPrecision
+	}{
+		{
+			name: "TestIndexBasicGetProximityPrecision",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: ProximityPrecisionByWord,
+		},
+		{
+			name: "TestIndexGetProximityPrecisionWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: ProximityPrecisionByWord,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetProximityPrecision()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateProximityPrecision(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request ProximityPrecision
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+	}{
+		{
+			name: "TestIndexBasicUpdateProximityPrecision",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: ProximityPrecisionByAttribute,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateProximityPrecisionWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: ProximityPrecisionByAttribute,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateProximityPrecision(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetProximityPrecision()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, *gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetProximityPrecision(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request ProximityPrecision
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp ProximityPrecision
+	}{
+		{
+			name: "TestIndexBasicResetProximityPrecision",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: ProximityPrecisionByAttribute,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: ProximityPrecisionByWord,
+		},
+		{
+			name: "TestIndexResetProximityPrecisionWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: ProximityPrecisionByAttribute,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: ProximityPrecisionByWord,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateProximityPrecision(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetProximityPrecision()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetProximityPrecision()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_GetSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp []string
+	}{
+		{
+			name: "TestIndexBasicGetSeparatorTokens",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: []string{},
+		},
+		{
+			name: "TestIndexGetSeparatorTokensWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+	}{
+		{
+			name: "TestIndexBasicUpdateSeparatorTokens",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: []string{"&", "|"},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateSeparatorTokensWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: []string{"&", "|"},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateSeparatorTokens(&tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, *gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp []string
+	}{
+		{
+			name: "TestIndexBasicResetSeparatorTokens",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: []string{"&", "|"},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: []string{},
+		},
+		{
+			name: "TestIndexResetSeparatorTokensWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: []string{"&", "|"},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateSeparatorTokens(&tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetSeparatorTokens()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_GetNonSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp []string
+	}{
+		{
+			name: "TestIndexBasicGetNonSeparatorTokens",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: []string{},
+		},
+		{
+			name: "TestIndexGetNonSeparatorTokensWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetNonSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateNonSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+	}{
+		{
+			name: "TestIndexBasicUpdateNonSeparatorTokens",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: []string{"@", "#"},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateNonSeparatorTokensWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: []string{"@", "#"},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateNonSeparatorTokens(&tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetNonSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, *gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetNonSeparatorTokens(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp []string
+	}{
+		{
+			name: "TestIndexBasicResetNonSeparatorTokens",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: []string{"@", "#"},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: []string{},
+		},
+		{
+			name: "TestIndexResetNonSeparatorTokensWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: []string{"@", "#"},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateNonSeparatorTokens(&tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetNonSeparatorTokens()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetNonSeparatorTokens()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_GetDictionary(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp []string
+	}{
+		{
+			name: "TestIndexBasicGetDictionary",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: []string{},
+		},
+		{
+			name: "TestIndexGetDictionaryWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetDictionary()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateDictionary(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+	}{
+		{
+			name: "TestIndexBasicUpdateDictionary",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: []string{"J. R. R.", "W. E. B."},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateDictionaryWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: []string{"J. R. R.", "W. E. B."},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateDictionary(&tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetDictionary()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, *gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetDictionary(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request []string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp []string
+	}{
+		{
+			name: "TestIndexBasicResetDictionary",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: []string{"J. R. R.", "W. E. B."},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: []string{},
+		},
+		{
+			name: "TestIndexResetDictionaryWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: []string{"J. R. R.", "W. E. B."},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateDictionary(&tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetDictionary()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetDictionary()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_GetSearchCutoffMs(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp int64
+	}{
+		{
+			name: "TestIndexBasicGetSearchCutoffMs",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: int64(0),
+		},
+		{
+			name: "TestIndexGetSearchCutoffMsWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: int64(0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetSearchCutoffMs()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateSearchCutoffMs(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request int64
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+	}{
+		{
+			name: "TestIndexBasicUpdateSearchCutoffMs",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: int64(150),
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateSearchCutoffMsWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: int64(150),
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateSearchCutoffMs(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetSearchCutoffMs()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, *gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetSearchCutoffMs(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request int64
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp int64
+	}{
+		{
+			name: "TestIndexBasicResetSearchCutoffMs",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: int64(150),
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: int64(0),
+		},
+		{
+			name: "TestIndexResetSearchCutoffMsWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: int64(150),
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: int64(0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateSearchCutoffMs(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetSearchCutoffMs()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetSearchCutoffMs()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_GetLocalizedAttributes(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp []LocalizedAttributes
+	}{
+		{
+			name: "TestIndexBasicGetLocalizedAttributes",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: []LocalizedAttributes(nil),
+		},
+		{
+			name: "TestIndexGetLocalizedAttributesWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: []LocalizedAttributes(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetLocalizedAttributes()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateLocalizedAttributes(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request []LocalizedAttributes
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+	}{
+		{
+			name: "TestIndexBasicUpdateLocalizedAttributes",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				request: []LocalizedAttributes{
+					{AttributePatterns: []string{"title"}, Locales: []string{"eng"}},
+					{AttributePatterns: []string{"*_ja"}, Locales: []string{"jpn"}},
+				},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateLocalizedAttributesWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+				request: []LocalizedAttributes{
+					{AttributePatterns: []string{"title"}, Locales: []string{"eng"}},
+					{AttributePatterns: []string{"*_ja"}, Locales: []string{"jpn"}},
+				},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateLocalizedAttributes(&tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetLocalizedAttributes()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, *gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetLocalizedAttributes(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request []LocalizedAttributes
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp []LocalizedAttributes
+	}{
+		{
+			name: "TestIndexBasicResetLocalizedAttributes",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+				request: []LocalizedAttributes{
+					{AttributePatterns: []string{"title"}, Locales: []string{"eng"}},
+					{AttributePatterns: []string{"*_ja"}, Locales: []string{"jpn"}},
+				},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: []LocalizedAttributes(nil),
+		},
+		{
+			name: "TestIndexResetLocalizedAttributesWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+				request: []LocalizedAttributes{
+					{AttributePatterns: []string{"title"}, Locales: []string{"eng"}},
+					{AttributePatterns: []string{"*_ja"}, Locales: []string{"jpn"}},
+				},
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: []LocalizedAttributes(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateLocalizedAttributes(&tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetLocalizedAttributes()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetLocalizedAttributes()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_GetFacetSearch(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp bool
+	}{
+		{
+			name: "TestIndexBasicGetFacetSearch",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: true,
+		},
+		{
+			name: "TestIndexGetFacetSearchWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetFacetSearch()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdateFacetSearch(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request bool
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+	}{
+		{
+			name: "TestIndexBasicUpdateFacetSearch",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: false,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdateFacetSearchWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: false,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdateFacetSearch(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetFacetSearch()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, *gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetFacetSearch(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request bool
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp bool
+	}{
+		{
+			name: "TestIndexBasicResetFacetSearch",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: false,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: true,
+		},
+		{
+			name: "TestIndexResetFacetSearchWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: false,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdateFacetSearch(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetFacetSearch()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetFacetSearch()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_GetPrefixSearch(t *testing.T) {
+	type args struct {
+		UID    string
+		client *Client
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantResp PrefixSearch
+	}{
+		{
+			name: "TestIndexBasicGetPrefixSearch",
+			args: args{
+				UID:    "indexUID",
+				client: defaultClient,
+			},
+			wantResp: PrefixSearchIndexingTime,
+		},
+		{
+			name: "TestIndexGetPrefixSearchWithCustomClient",
+			args: args{
+				UID:    "indexUID",
+				client: customClient,
+			},
+			wantResp: PrefixSearchIndexingTime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotResp, err := i.GetPrefixSearch()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
+
+func TestIndex_UpdatePrefixSearch(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request PrefixSearch
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+	}{
+		{
+			name: "TestIndexBasicUpdatePrefixSearch",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: PrefixSearchDisabled,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+		{
+			name: "TestIndexUpdatePrefixSearchWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: PrefixSearchDisabled,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			gotTask, err := i.UpdatePrefixSearch(tt.args.request)
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetPrefixSearch()
+			require.NoError(t, err)
+			require.Equal(t, tt.args.request, *gotResp)
+		})
+	}
+}
+
+func TestIndex_ResetPrefixSearch(t *testing.T) {
+	type args struct {
+		UID     string
+		client  *Client
+		request PrefixSearch
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantTask *TaskInfo
+		wantResp PrefixSearch
+	}{
+		{
+			name: "TestIndexBasicResetPrefixSearch",
+			args: args{
+				UID:     "indexUID",
+				client:  defaultClient,
+				request: PrefixSearchDisabled,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: PrefixSearchIndexingTime,
+		},
+		{
+			name: "TestIndexResetPrefixSearchWithCustomClient",
+			args: args{
+				UID:     "indexUID",
+				client:  customClient,
+				request: PrefixSearchDisabled,
+			},
+			wantTask: &TaskInfo{
+				TaskUID: 1,
+			},
+			wantResp: PrefixSearchIndexingTime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetUpIndexForFaceting()
+			c := tt.args.client
+			i := c.Index(tt.args.UID)
+			t.Cleanup(cleanup(c))
+
+			task, err := i.UpdatePrefixSearch(tt.args.request)
+			require.NoError(t, err)
+			testWaitForTask(t, i, task)
+
+			gotTask, err := i.ResetPrefixSearch()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, gotTask.TaskUID, tt.wantTask.TaskUID)
+			testWaitForTask(t, i, gotTask)
+
+			gotResp, err := i.GetPrefixSearch()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantResp, *gotResp)
+		})
+	}
+}
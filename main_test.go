@@ -3,6 +3,7 @@ package meilisearch
 import (
 	"crypto/tls"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
@@ -245,6 +246,7 @@ var (
 	defaultFaceting = Faceting{
 		MaxValuesPerFacet: 100,
 	}
+	defaultFacetSearch = true
 )
 
 var customClient = NewFastHTTPCustomClient(ClientConfig{
@@ -256,6 +258,14 @@ var customClient = NewFastHTTPCustomClient(ClientConfig{
 		Name:      "custom-client",
 	})
 
+var netHTTPClient = NewNetHTTPCustomClient(ClientConfig{
+	Host:   getenv("MEILISEARCH_URL", "http://localhost:7700"),
+	APIKey: masterKey,
+},
+	&http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	})
+
 var timeoutClient = NewClient(ClientConfig{
 	Host:    getenv("MEILISEARCH_URL", "http://localhost:7700"),
 	APIKey:  masterKey,
@@ -0,0 +1,241 @@
+// Package otelmeilisearch instruments the requests of a meilisearch.Client with OpenTelemetry.
+//
+// It lives in its own module so that the Meilisearch client does not depend on OpenTelemetry:
+//
+//	client := meilisearch.NewClient(meilisearch.ClientConfig{
+//		Host:        "http://localhost:7700",
+//		Middlewares: []meilisearch.Middleware{otelmeilisearch.NewMiddleware()},
+//	})
+//
+// Each request is traced by a client span, and measured by the histograms
+// meilisearch.client.request.duration, meilisearch.client.request.body.size and
+// meilisearch.client.response.body.size. The span context is sent to Meilisearch
+// in the traceparent header.
+package otelmeilisearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/meilisearch/meilisearch-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer and of the meter
+const instrumentationName = "github.com/meilisearch/meilisearch-go/otelmeilisearch"
+
+// Attribute keys set on the spans and the measurements, in addition to
+// http.request.method, url.template and http.response.status_code
+const (
+	FunctionKey     = attribute.Key("meilisearch.function")
+	IndexUIDKey     = attribute.Key("meilisearch.index_uid")
+	TaskUIDKey      = attribute.Key("meilisearch.task_uid")
+	ErrCodeKey      = attribute.Key("meilisearch.err_code")
+	APIErrorCodeKey = attribute.Key("meilisearch.api_error_code")
+	AttemptKey      = attribute.Key("meilisearch.attempt")
+
+	methodKey      = attribute.Key("http.request.method")
+	urlTemplateKey = attribute.Key("url.template")
+	statusCodeKey  = attribute.Key("http.response.status_code")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// Option configures the middleware created by NewMiddleware
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider creating the spans, the global one by default
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the MeterProvider creating the histograms, the global one by default
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// WithPropagator sets the propagator injecting the span context in the request headers,
+// propagation.TraceContext by default
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = propagator
+	}
+}
+
+type instrumentation struct {
+	tracer       trace.Tracer
+	propagator   propagation.TextMapPropagator
+	duration     metric.Float64Histogram
+	requestSize  metric.Int64Histogram
+	responseSize metric.Int64Histogram
+}
+
+// NewMiddleware returns a meilisearch.Middleware tracing and measuring the requests of the Client
+func NewMiddleware(opts ...Option) meilisearch.Middleware {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+		propagator:     propagation.TraceContext{},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	meter := cfg.meterProvider.Meter(instrumentationName)
+	inst := &instrumentation{
+		tracer:     cfg.tracerProvider.Tracer(instrumentationName),
+		propagator: cfg.propagator,
+	}
+	var err error
+	inst.duration, err = meter.Float64Histogram("meilisearch.client.request.duration",
+		metric.WithDescription("Duration of the requests sent to Meilisearch"),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	inst.requestSize, err = meter.Int64Histogram("meilisearch.client.request.body.size",
+		metric.WithDescription("Size of the bodies of the requests sent to Meilisearch"),
+		metric.WithUnit("By"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	inst.responseSize, err = meter.Int64Histogram("meilisearch.client.response.body.size",
+		metric.WithDescription("Size of the bodies of the responses sent by Meilisearch"),
+		metric.WithUnit("By"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return inst.middleware
+}
+
+func (inst *instrumentation) middleware(next meilisearch.RequestHandler) meilisearch.RequestHandler {
+	return func(ctx context.Context, req *meilisearch.Request) (*meilisearch.TransportResponse, error) {
+		template, indexUID := endpointTemplate(req.Endpoint)
+		attrs := []attribute.KeyValue{
+			FunctionKey.String(req.FunctionName),
+			methodKey.String(req.Method),
+			urlTemplateKey.String(template),
+		}
+
+		spanAttrs := append([]attribute.KeyValue(nil), attrs...)
+		if indexUID != "" {
+			spanAttrs = append(spanAttrs, IndexUIDKey.String(indexUID))
+		}
+		if req.Attempt > 1 {
+			spanAttrs = append(spanAttrs, AttemptKey.Int(req.Attempt))
+		}
+		ctx, span := inst.tracer.Start(ctx, req.FunctionName,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(spanAttrs...))
+		defer span.End()
+
+		inst.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+		elapsed := time.Since(start)
+
+		// outcome holds the attributes known once the request is sent
+		var outcome []attribute.KeyValue
+		if resp != nil {
+			outcome = append(outcome, statusCodeKey.Int(resp.StatusCode))
+			if taskUID, ok := responseTaskUID(resp.Body); ok {
+				span.SetAttributes(TaskUIDKey.Int64(taskUID))
+			}
+		}
+		if err != nil {
+			var meilisearchErr *meilisearch.Error
+			if errors.As(err, &meilisearchErr) {
+				outcome = append(outcome, ErrCodeKey.Int(int(meilisearchErr.ErrCode)))
+				if code := meilisearchErr.MeilisearchApiError.Code; code != "" {
+					outcome = append(outcome, APIErrorCodeKey.String(code))
+				}
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.SetAttributes(outcome...)
+		attrs = append(attrs, outcome...)
+
+		measured := metric.WithAttributes(attrs...)
+		if inst.duration != nil {
+			inst.duration.Record(ctx, elapsed.Seconds(), measured)
+		}
+		if inst.requestSize != nil && req.BodyStream == nil {
+			inst.requestSize.Record(ctx, int64(len(req.Body)), measured)
+		}
+		if inst.responseSize != nil && resp != nil {
+			inst.responseSize.Record(ctx, int64(len(resp.Body)), measured)
+		}
+		return resp, err
+	}
+}
+
+// endpointTemplate replaces the identifiers of the endpoint by placeholders to keep a low cardinality,
+// e.g. "/indexes/movies/documents/42" gives "/indexes/{indexUid}/documents/{documentId}" and "movies"
+func endpointTemplate(endpoint string) (template, indexUID string) {
+	segments := strings.Split(endpoint, "/")
+	for i := 1; i < len(segments); i++ {
+		segment := segments[i]
+		if segment == "" {
+			continue
+		}
+		switch segments[i-1] {
+		case "indexes":
+			indexUID = segment
+			segments[i] = "{indexUid}"
+		case "documents":
+			if segment != "fetch" && segment != "delete" && segment != "delete-batch" {
+				segments[i] = "{documentId}"
+			}
+		case "keys":
+			segments[i] = "{key}"
+		case "tasks":
+			if isDigits(segment) {
+				segments[i] = "{taskUid}"
+			}
+		}
+	}
+	return strings.Join(segments, "/"), indexUID
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// responseTaskUID returns the uid of the task enqueued by the request, if any
+func responseTaskUID(body []byte) (int64, bool) {
+	if !bytes.Contains(body, []byte(`"taskUid"`)) {
+		return 0, false
+	}
+	var taskInfo struct {
+		TaskUID *int64 `json:"taskUid"`
+	}
+	if err := json.Unmarshal(body, &taskInfo); err != nil || taskInfo.TaskUID == nil {
+		return 0, false
+	}
+	return *taskInfo.TaskUID, true
+}
@@ -0,0 +1,120 @@
+package otelmeilisearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	var traceparents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/indexes/movies/documents":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"taskUid":42,"indexUid":"movies","status":"enqueued","type":"documentAdditionOrUpdate"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Index ` + "`unknown`" + ` not found.","code":"index_not_found","type":"invalid_request","link":""}`))
+		}
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	client := meilisearch.NewClient(meilisearch.ClientConfig{
+		Host: server.URL,
+		Middlewares: []meilisearch.Middleware{NewMiddleware(
+			WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+			WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		)},
+	})
+
+	_, err := client.Index("movies").AddDocuments([]map[string]interface{}{{"id": 1}})
+	require.NoError(t, err)
+	err = client.Index("unknown").GetDocument("1", nil, &map[string]interface{}{})
+	require.Error(t, err)
+
+	ended := spans.Ended()
+	require.Len(t, ended, 2)
+	require.Len(t, traceparents, 2)
+
+	require.Equal(t, "AddDocuments", ended[0].Name())
+	require.Equal(t, trace.SpanKindClient, ended[0].SpanKind())
+	require.Equal(t, codes.Unset, ended[0].Status().Code)
+	require.Subset(t, ended[0].Attributes(), []attribute.KeyValue{
+		FunctionKey.String("AddDocuments"),
+		IndexUIDKey.String("movies"),
+		urlTemplateKey.String("/indexes/{indexUid}/documents"),
+		methodKey.String(http.MethodPost),
+		statusCodeKey.Int(http.StatusAccepted),
+		TaskUIDKey.Int64(42),
+	})
+	require.Contains(t, traceparents[0], ended[0].SpanContext().TraceID().String())
+	require.Contains(t, traceparents[0], ended[0].SpanContext().SpanID().String())
+
+	require.Equal(t, "GetDocument", ended[1].Name())
+	require.Equal(t, codes.Error, ended[1].Status().Code)
+	require.Subset(t, ended[1].Attributes(), []attribute.KeyValue{
+		IndexUIDKey.String("unknown"),
+		urlTemplateKey.String("/indexes/{indexUid}/documents/{documentId}"),
+		statusCodeKey.Int(http.StatusNotFound),
+		ErrCodeKey.Int(int(meilisearch.MeilisearchApiError)),
+		APIErrorCodeKey.String("index_not_found"),
+	})
+
+	var metrics metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &metrics))
+	require.Len(t, metrics.ScopeMetrics, 1)
+	histograms := map[string]int{}
+	for _, m := range metrics.ScopeMetrics[0].Metrics {
+		switch data := m.Data.(type) {
+		case metricdata.Histogram[float64]:
+			histograms[m.Name] = len(data.DataPoints)
+		case metricdata.Histogram[int64]:
+			histograms[m.Name] = len(data.DataPoints)
+		}
+	}
+	require.Equal(t, map[string]int{
+		"meilisearch.client.request.duration":   2,
+		"meilisearch.client.request.body.size":  2,
+		"meilisearch.client.response.body.size": 2,
+	}, histograms)
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := []struct {
+		endpoint     string
+		wantTemplate string
+		wantIndexUID string
+	}{
+		{endpoint: "/health", wantTemplate: "/health"},
+		{endpoint: "/indexes", wantTemplate: "/indexes"},
+		{endpoint: "/indexes/movies/search", wantTemplate: "/indexes/{indexUid}/search", wantIndexUID: "movies"},
+		{endpoint: "/indexes/movies/documents/fetch", wantTemplate: "/indexes/{indexUid}/documents/fetch", wantIndexUID: "movies"},
+		{endpoint: "/indexes/movies/documents/tt0110912", wantTemplate: "/indexes/{indexUid}/documents/{documentId}", wantIndexUID: "movies"},
+		{endpoint: "/tasks/12", wantTemplate: "/tasks/{taskUid}"},
+		{endpoint: "/tasks/cancel", wantTemplate: "/tasks/cancel"},
+		{endpoint: "/keys/6062abda-a5aa-4414-ac91-ecd7944c0f8d", wantTemplate: "/keys/{key}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			gotTemplate, gotIndexUID := endpointTemplate(tt.endpoint)
+			require.Equal(t, tt.wantTemplate, gotTemplate)
+			require.Equal(t, tt.wantIndexUID, gotIndexUID)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package meilisearch
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// fastHTTPMaxRequestDuration bounds the requests sent by the fasthttp Transport with a
+// context that can be canceled but has no deadline. fasthttp can not abort a request in
+// flight, so once the context is canceled the request is abandoned and keeps running
+// until this deadline, unless the fasthttp.Client has its own read and write timeouts.
+var fastHTTPMaxRequestDuration = 10 * time.Minute
+
+// Transport sends the requests built by the Client to Meilisearch.
+//
+// A Transport must honour the deadline and the cancellation of ctx, and must
+// return an error only when no response could be read from the server. Any
+// status code is a valid response and is handled by the Client.
+type Transport interface {
+	Do(ctx context.Context, request *TransportRequest) (*TransportResponse, error)
+}
+
+// TransportRequest is the HTTP request handed to a Transport
+type TransportRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+
+	// Body is the raw request body, nil when the request has no body
+	Body []byte
+
+	// BodyStream is set instead of Body when the body is streamed from an
+	// io.Reader
+	BodyStream io.Reader
+}
+
+// TransportResponse is the HTTP response read by a Transport
+type TransportResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// fastHTTPTransport is the Transport backed by a fasthttp.Client
+type fastHTTPTransport struct {
+	client *fasthttp.Client
+}
+
+// NewFastHTTPTransport creates a Transport sending requests with a fasthttp.Client.
+//
+// fasthttp can not abort a request in flight: when the context of a request is canceled
+// the Transport returns at once, but the request keeps running in the background until
+// it completes or reaches its deadline. The deadline is the one of the context, or 10
+// minutes when the context has none. Set ClientConfig.Timeout, or the ReadTimeout and
+// WriteTimeout of the fasthttp.Client, to free the connections sooner.
+func NewFastHTTPTransport(client *fasthttp.Client) Transport {
+	return &fastHTTPTransport{client: client}
+}
+
+func (t *fastHTTPTransport) Do(ctx context.Context, req *TransportRequest) (*TransportResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	request := fasthttp.AcquireRequest()
+	response := fasthttp.AcquireResponse()
+
+	request.SetRequestURI(req.URL)
+	request.Header.SetMethod(req.Method)
+	for key, values := range req.Header {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+	if req.BodyStream != nil {
+		// NOTE: Avoid using this, due to problems with streamed request bodies
+		request.SetBodyStream(req.BodyStream, -1)
+	} else if req.Body != nil {
+		request.SetBody(req.Body)
+	}
+
+	deadline, hasDeadline := ctx.Deadline()
+	if !hasDeadline && ctx.Done() != nil {
+		// The request is abandoned when ctx is canceled, it must not run forever
+		deadline, hasDeadline = time.Now().Add(fastHTTPMaxRequestDuration), true
+	}
+	do := func() error {
+		if hasDeadline {
+			return t.client.DoDeadline(request, response, deadline)
+		}
+		return t.client.Do(request, response)
+	}
+
+	// A context that can never be canceled does not need to be watched
+	if ctx.Done() == nil {
+		defer fasthttp.ReleaseRequest(request)
+		defer fasthttp.ReleaseResponse(response)
+		if err := do(); err != nil {
+			return nil, err
+		}
+		return newFastHTTPTransportResponse(response), nil
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- do()
+	}()
+
+	select {
+	case err := <-done:
+		defer fasthttp.ReleaseRequest(request)
+		defer fasthttp.ReleaseResponse(response)
+		if err != nil {
+			return nil, err
+		}
+		return newFastHTTPTransportResponse(response), nil
+	case <-ctx.Done():
+		// The request may still be in flight, so request and response are not
+		// released to the pool and are left to the garbage collector instead
+		return nil, ctx.Err()
+	}
+}
+
+func newFastHTTPTransportResponse(response *fasthttp.Response) *TransportResponse {
+	resp := &TransportResponse{
+		StatusCode: response.StatusCode(),
+		Header:     http.Header{},
+		// The body is copied since the response is released to the pool
+		Body: append([]byte(nil), response.Body()...),
+	}
+	response.Header.VisitAll(func(key, value []byte) {
+		resp.Header.Add(string(key), string(value))
+	})
+	return resp
+}
+
+// netHTTPTransport is the Transport backed by a net/http Client
+type netHTTPTransport struct {
+	client *http.Client
+}
+
+// NewNetHTTPTransport creates a Transport sending requests with a net/http Client
+func NewNetHTTPTransport(client *http.Client) Transport {
+	return &netHTTPTransport{client: client}
+}
+
+func (t *netHTTPTransport) Do(ctx context.Context, req *TransportRequest) (*TransportResponse, error) {
+	var body io.Reader
+	if req.BodyStream != nil {
+		body = req.BodyStream
+	} else if req.Body != nil {
+		body = bytes.NewReader(req.Body)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, req.Method, req.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Header != nil {
+		request.Header = req.Header.Clone()
+	}
+
+	response, err := t.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &TransportResponse{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		Body:       data,
+	}, nil
+}
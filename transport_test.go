@@ -0,0 +1,160 @@
+package meilisearch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func newTestTransports() map[string]Transport {
+	return map[string]Transport{
+		"fasthttp": NewFastHTTPTransport(&fasthttp.Client{}),
+		"net/http": NewNetHTTPTransport(&http.Client{}),
+	}
+}
+
+func TestTransport_Do(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Content-Type", r.Header.Get("Content-Type"))
+		w.Header().Set("X-Query", r.URL.RawQuery)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		request *TransportRequest
+		want    string
+	}{
+		{
+			name: "TestTransportDoWithBody",
+			request: &TransportRequest{
+				Method: http.MethodPost,
+				URL:    server.URL + "/indexes?limit=1",
+				Header: http.Header{"Content-Type": []string{contentTypeJSON}},
+				Body:   []byte(`{"uid":"movies"}`),
+			},
+			want: `{"uid":"movies"}`,
+		},
+		{
+			name: "TestTransportDoWithBodyStream",
+			request: &TransportRequest{
+				Method:     http.MethodPut,
+				URL:        server.URL + "/indexes?limit=1",
+				Header:     http.Header{"Content-Type": []string{contentTypeCSV}},
+				BodyStream: strings.NewReader("id,name\n1,movie\n"),
+			},
+			want: "id,name\n1,movie\n",
+		},
+	}
+	for transportName, transport := range newTestTransports() {
+		for _, tt := range tests {
+			t.Run(tt.name+"/"+transportName, func(t *testing.T) {
+				request := *tt.request
+				if tt.request.BodyStream != nil {
+					request.BodyStream = strings.NewReader(tt.want)
+				}
+
+				got, err := transport.Do(context.Background(), &request)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusAccepted, got.StatusCode)
+				require.Equal(t, tt.request.Method, got.Header.Get("X-Method"))
+				require.Equal(t, tt.request.Header.Get("Content-Type"), got.Header.Get("X-Content-Type"))
+				require.Equal(t, "limit=1", got.Header.Get("X-Query"))
+				require.Equal(t, tt.want, string(got.Body))
+			})
+		}
+	}
+}
+
+func TestTransport_DoWithCanceledContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	for transportName, transport := range newTestTransports() {
+		t.Run(transportName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			got, err := transport.Do(ctx, &TransportRequest{
+				Method: http.MethodGet,
+				URL:    server.URL + "/health",
+			})
+			require.Error(t, err)
+			require.ErrorIs(t, err, context.Canceled)
+			require.Nil(t, got)
+		})
+	}
+}
+
+func TestTransport_DoFastHTTPWithoutDeadline(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	maxRequestDuration := fastHTTPMaxRequestDuration
+	fastHTTPMaxRequestDuration = 50 * time.Millisecond
+	defer func() { fastHTTPMaxRequestDuration = maxRequestDuration }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	got, err := NewFastHTTPTransport(&fasthttp.Client{}).Do(ctx, &TransportRequest{
+		Method: http.MethodGet,
+		URL:    server.URL + "/health",
+	})
+	require.ErrorIs(t, err, fasthttp.ErrTimeout)
+	require.Nil(t, got)
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", contentTypeJSON)
+		_, _ = w.Write([]byte(`{"pkgVersion":"` + r.Header.Get("Authorization") + `"}`))
+	}))
+	defer server.Close()
+
+	for transportName, transport := range newTestTransports() {
+		t.Run(transportName, func(t *testing.T) {
+			c := NewClientWithTransport(ClientConfig{
+				Host:    server.URL,
+				APIKey:  masterKey,
+				Timeout: 100 * time.Millisecond,
+			}, transport)
+
+			got, err := c.GetVersion()
+			require.NoError(t, err)
+			require.Equal(t, "Bearer "+masterKey, got.PkgVersion)
+
+			err = c.executeRequest(context.Background(), internalRequest{
+				endpoint:            "/slow",
+				method:              http.MethodGet,
+				withResponse:        &Version{},
+				acceptedStatusCodes: []int{http.StatusOK},
+				functionName:        "Slow",
+			})
+			require.Error(t, err)
+			require.Equal(t, MeilisearchTimeoutError, err.(*Error).ErrCode)
+		})
+	}
+}
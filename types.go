@@ -4,7 +4,6 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
-	"github.com/valyala/fasthttp"
 )
 
 //
@@ -13,8 +12,11 @@ import (
 
 // Client is a structure that give you the power for interacting with an high-level api with Meilisearch.
 type Client struct {
-	config     ClientConfig
-	httpClient *fasthttp.Client
+	config    ClientConfig
+	transport Transport
+
+	// middlewares are the ClientConfig.Middlewares followed by the internal ones
+	middlewares []Middleware
 }
 
 // Index is the type that represent an index in Meilisearch
@@ -41,17 +43,26 @@ type IndexesQuery struct {
 
 // Settings is the type that represents the settings in Meilisearch
 type Settings struct {
-	RankingRules         []string            `json:"rankingRules,omitempty"`
-	DistinctAttribute    *string             `json:"distinctAttribute,omitempty"`
-	SearchableAttributes []string            `json:"searchableAttributes,omitempty"`
-	DisplayedAttributes  []string            `json:"displayedAttributes,omitempty"`
-	StopWords            []string            `json:"stopWords,omitempty"`
-	Synonyms             map[string][]string `json:"synonyms,omitempty"`
-	FilterableAttributes []string            `json:"filterableAttributes,omitempty"`
-	SortableAttributes   []string            `json:"sortableAttributes,omitempty"`
-	TypoTolerance        *TypoTolerance      `json:"typoTolerance,omitempty"`
-	Pagination           *Pagination         `json:"pagination,omitempty"`
-	Faceting             *Faceting           `json:"faceting,omitempty"`
+	RankingRules         []string              `json:"rankingRules,omitempty"`
+	DistinctAttribute    *string               `json:"distinctAttribute,omitempty"`
+	SearchableAttributes []string              `json:"searchableAttributes,omitempty"`
+	DisplayedAttributes  []string              `json:"displayedAttributes,omitempty"`
+	StopWords            []string              `json:"stopWords,omitempty"`
+	Synonyms             map[string][]string   `json:"synonyms,omitempty"`
+	FilterableAttributes []string              `json:"filterableAttributes,omitempty"`
+	SortableAttributes   []string              `json:"sortableAttributes,omitempty"`
+	TypoTolerance        *TypoTolerance        `json:"typoTolerance,omitempty"`
+	Pagination           *Pagination           `json:"pagination,omitempty"`
+	Faceting             *Faceting             `json:"faceting,omitempty"`
+	Embedders            map[string]Embedder   `json:"embedders,omitempty"`
+	ProximityPrecision   ProximityPrecision    `json:"proximityPrecision,omitempty"`
+	SeparatorTokens      []string              `json:"separatorTokens,omitempty"`
+	NonSeparatorTokens   []string              `json:"nonSeparatorTokens,omitempty"`
+	Dictionary           []string              `json:"dictionary,omitempty"`
+	SearchCutoffMs       int64                 `json:"searchCutoffMs,omitempty"`
+	LocalizedAttributes  []LocalizedAttributes `json:"localizedAttributes,omitempty"`
+	FacetSearch          *bool                 `json:"facetSearch,omitempty"`
+	PrefixSearch         PrefixSearch          `json:"prefixSearch,omitempty"`
 }
 
 // TypoTolerance is the type that represents the typo tolerance setting in Meilisearch
@@ -78,6 +89,84 @@ type Faceting struct {
 	MaxValuesPerFacet int64 `json:"maxValuesPerFacet"`
 }
 
+// ProximityPrecision is the precision of the distance between words computed by the proximity ranking rule
+type ProximityPrecision string
+
+const (
+	// ProximityPrecisionByWord computes the exact distance between words
+	ProximityPrecisionByWord ProximityPrecision = "byWord"
+	// ProximityPrecisionByAttribute only checks whether words are in the same attribute, making indexing faster
+	ProximityPrecisionByAttribute ProximityPrecision = "byAttribute"
+)
+
+// PrefixSearch is the way of matching the query terms with the beginning of the words of the documents
+type PrefixSearch string
+
+const (
+	// PrefixSearchIndexingTime computes the prefixes when indexing the documents
+	PrefixSearchIndexingTime PrefixSearch = "indexingTime"
+	// PrefixSearchDisabled only matches complete words, making indexing faster
+	PrefixSearchDisabled PrefixSearch = "disabled"
+)
+
+// LocalizedAttributes sets the languages of the attributes matching AttributePatterns,
+// the Locales being ISO 639-3 codes such as "eng" or "jpn"
+type LocalizedAttributes struct {
+	AttributePatterns []string `json:"attributePatterns"`
+	Locales           []string `json:"locales"`
+}
+
+// EmbedderSource is the kind of an embedder
+type EmbedderSource string
+
+const (
+	// EmbedderSourceUserProvided embedders use the vectors given in the `_vectors` field of the documents
+	EmbedderSourceUserProvided EmbedderSource = "userProvided"
+	// EmbedderSourceOpenAI embedders compute the vectors with the OpenAI API
+	EmbedderSourceOpenAI EmbedderSource = "openAi"
+	// EmbedderSourceHuggingFace embedders compute the vectors locally with a Hugging Face model
+	EmbedderSourceHuggingFace EmbedderSource = "huggingFace"
+	// EmbedderSourceOllama embedders compute the vectors with an Ollama server
+	EmbedderSourceOllama EmbedderSource = "ollama"
+	// EmbedderSourceREST embedders compute the vectors with any REST API, described by Request and Response
+	EmbedderSourceREST EmbedderSource = "rest"
+)
+
+// Embedder is the type that represents an embedder of the embedders setting in Meilisearch.
+// Which fields are allowed depends on the Source of the embedder.
+//
+// Documentation: https://www.meilisearch.com/docs/reference/api/settings#embedders
+type Embedder struct {
+	Source EmbedderSource `json:"source"`
+	// Model is the model of the openAi, huggingFace and ollama embedders
+	Model string `json:"model,omitempty"`
+	// Revision is the commit of the huggingFace model
+	Revision string `json:"revision,omitempty"`
+	// APIKey authenticates the openAi, ollama and rest embedders
+	APIKey string `json:"apiKey,omitempty"`
+	// URL is the route of the openAi, ollama and rest embedders
+	URL string `json:"url,omitempty"`
+	// DocumentTemplate is the liquid template turning a document into the text embedded, for all the sources but userProvided
+	DocumentTemplate         string `json:"documentTemplate,omitempty"`
+	DocumentTemplateMaxBytes int64  `json:"documentTemplateMaxBytes,omitempty"`
+	// Dimensions is the number of dimensions of the vectors, required for the userProvided embedders
+	Dimensions   int64                 `json:"dimensions,omitempty"`
+	Distribution *EmbedderDistribution `json:"distribution,omitempty"`
+	// Request is the body sent by the rest embedders, the "{{text}}" placeholder being replaced by the text embedded
+	Request map[string]interface{} `json:"request,omitempty"`
+	// Response is the body returned to the rest embedders, the "{{embedding}}" placeholder locating the vector
+	Response        map[string]interface{} `json:"response,omitempty"`
+	Headers         map[string]string      `json:"headers,omitempty"`
+	BinaryQuantized bool                   `json:"binaryQuantized,omitempty"`
+}
+
+// EmbedderDistribution corrects the relevancy of the semantic search, by describing
+// the distribution of the ranking scores given by an embedder
+type EmbedderDistribution struct {
+	Mean  float64 `json:"mean"`
+	Sigma float64 `json:"sigma"`
+}
+
 // Version is the type that represents the versions in Meilisearch
 type Version struct {
 	CommitSha  string `json:"commitSha"`
@@ -189,27 +278,36 @@ type DeleteTasksQuery struct {
 }
 
 type Details struct {
-	ReceivedDocuments    int64               `json:"receivedDocuments,omitempty"`
-	IndexedDocuments     int64               `json:"indexedDocuments,omitempty"`
-	DeletedDocuments     int64               `json:"deletedDocuments,omitempty"`
-	PrimaryKey           string              `json:"primaryKey,omitempty"`
-	ProvidedIds          int64               `json:"providedIds,omitempty"`
-	RankingRules         []string            `json:"rankingRules,omitempty"`
-	DistinctAttribute    *string             `json:"distinctAttribute,omitempty"`
-	SearchableAttributes []string            `json:"searchableAttributes,omitempty"`
-	DisplayedAttributes  []string            `json:"displayedAttributes,omitempty"`
-	StopWords            []string            `json:"stopWords,omitempty"`
-	Synonyms             map[string][]string `json:"synonyms,omitempty"`
-	FilterableAttributes []string            `json:"filterableAttributes,omitempty"`
-	SortableAttributes   []string            `json:"sortableAttributes,omitempty"`
-	TypoTolerance        *TypoTolerance      `json:"typoTolerance,omitempty"`
-	Pagination           *Pagination         `json:"pagination,omitempty"`
-	Faceting             *Faceting           `json:"faceting,omitempty"`
-	MatchedTasks         int64               `json:"matchedTasks,omitempty"`
-	CanceledTasks        int64               `json:"canceledTasks,omitempty"`
-	DeletedTasks         int64               `json:"deletedTasks,omitempty"`
-	OriginalFilter       string              `json:"originalFilter,omitempty"`
-	Swaps                []SwapIndexesParams `json:"swaps,omitempty"`
+	ReceivedDocuments    int64                 `json:"receivedDocuments,omitempty"`
+	IndexedDocuments     int64                 `json:"indexedDocuments,omitempty"`
+	DeletedDocuments     int64                 `json:"deletedDocuments,omitempty"`
+	PrimaryKey           string                `json:"primaryKey,omitempty"`
+	ProvidedIds          int64                 `json:"providedIds,omitempty"`
+	RankingRules         []string              `json:"rankingRules,omitempty"`
+	DistinctAttribute    *string               `json:"distinctAttribute,omitempty"`
+	SearchableAttributes []string              `json:"searchableAttributes,omitempty"`
+	DisplayedAttributes  []string              `json:"displayedAttributes,omitempty"`
+	StopWords            []string              `json:"stopWords,omitempty"`
+	Synonyms             map[string][]string   `json:"synonyms,omitempty"`
+	FilterableAttributes []string              `json:"filterableAttributes,omitempty"`
+	SortableAttributes   []string              `json:"sortableAttributes,omitempty"`
+	TypoTolerance        *TypoTolerance        `json:"typoTolerance,omitempty"`
+	Pagination           *Pagination           `json:"pagination,omitempty"`
+	Faceting             *Faceting             `json:"faceting,omitempty"`
+	Embedders            map[string]Embedder   `json:"embedders,omitempty"`
+	ProximityPrecision   ProximityPrecision    `json:"proximityPrecision,omitempty"`
+	SeparatorTokens      []string              `json:"separatorTokens,omitempty"`
+	NonSeparatorTokens   []string              `json:"nonSeparatorTokens,omitempty"`
+	Dictionary           []string              `json:"dictionary,omitempty"`
+	SearchCutoffMs       int64                 `json:"searchCutoffMs,omitempty"`
+	LocalizedAttributes  []LocalizedAttributes `json:"localizedAttributes,omitempty"`
+	FacetSearch          *bool                 `json:"facetSearch,omitempty"`
+	PrefixSearch         PrefixSearch          `json:"prefixSearch,omitempty"`
+	MatchedTasks         int64                 `json:"matchedTasks,omitempty"`
+	CanceledTasks        int64                 `json:"canceledTasks,omitempty"`
+	DeletedTasks         int64                 `json:"deletedTasks,omitempty"`
+	OriginalFilter       string                `json:"originalFilter,omitempty"`
+	Swaps                []SwapIndexesParams   `json:"swaps,omitempty"`
 }
 
 // Return of multiple tasks is wrap in a TaskResult
@@ -298,38 +396,184 @@ type CreateIndexRequest struct {
 //
 // Documentation: https://docs.meilisearch.com/reference/features/search_parameters.html
 type SearchRequest struct {
-	Offset                int64
-	Limit                 int64
-	AttributesToRetrieve  []string
-	AttributesToCrop      []string
-	CropLength            int64
-	CropMarker            string
-	AttributesToHighlight []string
-	HighlightPreTag       string
-	HighlightPostTag      string
-	MatchingStrategy      string
-	Filter                interface{}
-	ShowMatchesPosition   bool
-	Facets                []string
-	PlaceholderSearch     bool
-	Sort                  []string
-	HitsPerPage           int64
-	Page                  int64
+	// IndexUID and Query are only used by Client.MultiSearch to target an index with a query
+	IndexUID                string
+	Query                   string
+	Offset                  int64
+	Limit                   int64
+	AttributesToRetrieve    []string
+	AttributesToCrop        []string
+	CropLength              int64
+	CropMarker              string
+	AttributesToHighlight   []string
+	HighlightPreTag         string
+	HighlightPostTag        string
+	MatchingStrategy        string
+	Filter                  interface{}
+	ShowMatchesPosition     bool
+	Facets                  []string
+	PlaceholderSearch       bool
+	Sort                    []string
+	HitsPerPage             int64
+	Page                    int64
+	FederationOptions       *FederationOptions
+	ShowRankingScore        bool
+	ShowRankingScoreDetails bool
+	// RankingScoreThreshold leaves out the hits whose ranking score is lower, between 0 and 1
+	RankingScoreThreshold float64
+	// Vector is the query embedding, for a search with an embedder using user provided vectors
+	Vector []float32
+	// Hybrid combines the keyword search with a semantic search using the embeddings of an embedder
+	Hybrid *SearchRequestHybrid
+	// RetrieveVectors returns the `_vectors` field of the hits
+	RetrieveVectors bool
+}
+
+// SearchRequestHybrid is the hybrid search parameter of a SearchRequest.
+// SemanticRatio goes from 0, a keyword only search, to 1, a semantic only
+// search. Meilisearch uses 0.5 when it is not set.
+type SearchRequestHybrid struct {
+	Embedder      string  `json:"embedder"`
+	SemanticRatio float64 `json:"semanticRatio,omitempty"`
+}
+
+// EmbedderVectors is the value of an embedder in the `_vectors` field of a document.
+// Documents sent to an embedder using user provided vectors must have a `_vectors` field
+// containing their embeddings, and Regenerate set to false.
+type EmbedderVectors struct {
+	Embeddings [][]float32 `json:"embeddings"`
+	Regenerate bool        `json:"regenerate"`
 }
 
 // SearchResponse is the response body for search method
 type SearchResponse struct {
+	IndexUID           string                      `json:"indexUid,omitempty"`
+	Hits               []interface{}               `json:"hits"`
+	EstimatedTotalHits int64                       `json:"estimatedTotalHits,omitempty"`
+	Offset             int64                       `json:"offset,omitempty"`
+	Limit              int64                       `json:"limit,omitempty"`
+	ProcessingTimeMs   int64                       `json:"processingTimeMs"`
+	Query              string                      `json:"query"`
+	FacetDistribution  map[string]map[string]int64 `json:"facetDistribution,omitempty"`
+	FacetStats         map[string]FacetStat        `json:"facetStats,omitempty"`
+	TotalHits          int64                       `json:"totalHits,omitempty"`
+	HitsPerPage        int64                       `json:"hitsPerPage,omitempty"`
+	Page               int64                       `json:"page,omitempty"`
+	TotalPages         int64                       `json:"totalPages,omitempty"`
+	SemanticHitCount   int64                       `json:"semanticHitCount,omitempty"`
+}
+
+// SimilarDocumentsQuery is the request body of the similar documents method. ID is set from the
+// argument of Index.SearchSimilarDocuments, and Embedder is the name of the embedder comparing
+// the documents.
+type SimilarDocumentsQuery struct {
+	ID                      string      `json:"id"`
+	Embedder                string      `json:"embedder,omitempty"`
+	AttributesToRetrieve    []string    `json:"attributesToRetrieve,omitempty"`
+	Offset                  int64       `json:"offset,omitempty"`
+	Limit                   int64       `json:"limit,omitempty"`
+	Filter                  interface{} `json:"filter,omitempty"`
+	ShowRankingScore        bool        `json:"showRankingScore,omitempty"`
+	ShowRankingScoreDetails bool        `json:"showRankingScoreDetails,omitempty"`
+	RankingScoreThreshold   float64     `json:"rankingScoreThreshold,omitempty"`
+	RetrieveVectors         bool        `json:"retrieveVectors,omitempty"`
+}
+
+// SimilarDocumentsResponse is the response body of the similar documents method
+type SimilarDocumentsResponse struct {
 	Hits               []interface{} `json:"hits"`
+	ID                 string        `json:"id"`
 	EstimatedTotalHits int64         `json:"estimatedTotalHits,omitempty"`
 	Offset             int64         `json:"offset,omitempty"`
 	Limit              int64         `json:"limit,omitempty"`
 	ProcessingTimeMs   int64         `json:"processingTimeMs"`
-	Query              string        `json:"query"`
-	FacetDistribution  interface{}   `json:"facetDistribution,omitempty"`
-	TotalHits          int64         `json:"totalHits,omitempty"`
-	HitsPerPage        int64         `json:"hitsPerPage,omitempty"`
-	Page               int64         `json:"page,omitempty"`
-	TotalPages         int64         `json:"totalPages,omitempty"`
+}
+
+// FacetSearchRequest is the request body of the facet search method. FacetName and FacetQuery are
+// set from the arguments of Index.FacetSearch, the other fields restrict the documents whose facet
+// values are searched like in a SearchRequest.
+type FacetSearchRequest struct {
+	FacetName            string      `json:"facetName"`
+	FacetQuery           string      `json:"facetQuery,omitempty"`
+	Query                string      `json:"q,omitempty"`
+	Filter               interface{} `json:"filter,omitempty"`
+	MatchingStrategy     string      `json:"matchingStrategy,omitempty"`
+	AttributesToSearchOn []string    `json:"attributesToSearchOn,omitempty"`
+}
+
+// FacetSearchResponse is the response body of the facet search method
+type FacetSearchResponse struct {
+	FacetHits        []FacetHit `json:"facetHits"`
+	FacetQuery       string     `json:"facetQuery"`
+	ProcessingTimeMs int64      `json:"processingTimeMs"`
+}
+
+// FacetHit is a facet value matching the facet query, with the number of documents having it
+type FacetHit struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// RankingScoreDetail is the contribution of a ranking rule to the ranking score of a hit, the
+// `_rankingScoreDetails` field of a hit being a map of the ranking rules to their RankingScoreDetail.
+// The fields set depend on the ranking rule, sort rules have a Value instead of a Score.
+type RankingScoreDetail struct {
+	Order                      int64       `json:"order"`
+	Score                      float64     `json:"score,omitempty"`
+	MatchingWords              int64       `json:"matchingWords,omitempty"`
+	MaxMatchingWords           int64       `json:"maxMatchingWords,omitempty"`
+	TypoCount                  int64       `json:"typoCount,omitempty"`
+	MaxTypoCount               int64       `json:"maxTypoCount,omitempty"`
+	AttributeRankingOrderScore float64     `json:"attributeRankingOrderScore,omitempty"`
+	QueryWordDistanceScore     float64     `json:"queryWordDistanceScore,omitempty"`
+	MatchType                  string      `json:"matchType,omitempty"`
+	Value                      interface{} `json:"value,omitempty"`
+	Distance                   float64     `json:"distance,omitempty"`
+	Similarity                 float64     `json:"similarity,omitempty"`
+}
+
+// FacetStat is the lowest and highest values of a numeric facet among the hits
+type FacetStat struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// MatchPosition is the position of a matched query term in an attribute of a hit
+type MatchPosition struct {
+	Start   int64   `json:"start"`
+	Length  int64   `json:"length"`
+	Indices []int64 `json:"indices,omitempty"`
+}
+
+// MultiSearchRequest is the request body for multi search method
+type MultiSearchRequest struct {
+	// Federation is only used by Client.FederatedMultiSearch, its offset and limit apply to the merged hits
+	Federation *MultiSearchFederation `json:"federation,omitempty"`
+	Queries    []SearchRequest        `json:"queries"`
+}
+
+// MultiSearchFederation is the request body part merging the results of a federated multi search
+type MultiSearchFederation struct {
+	Offset int64 `json:"offset,omitempty"`
+	Limit  int64 `json:"limit,omitempty"`
+}
+
+// FederationOptions are the options of one query in a federated multi search
+type FederationOptions struct {
+	// Weight multiplies the ranking score of the hits of the query, defaults to 1.0
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// HitFederation is the `_federation` field Meilisearch adds to each hit of a federated multi search
+type HitFederation struct {
+	IndexUID             string  `json:"indexUid"`
+	QueriesPosition      int64   `json:"queriesPosition"`
+	WeightedRankingScore float64 `json:"weightedRankingScore"`
+}
+
+// MultiSearchResponse is the response body for multi search method, results are in the order of the queries
+type MultiSearchResponse struct {
+	Results []SearchResponse `json:"results"`
 }
 
 // DocumentQuery is the request body get one documents method
@@ -342,6 +586,9 @@ type DocumentsQuery struct {
 	Offset int64    `json:"offset,omitempty"`
 	Limit  int64    `json:"limit,omitempty"`
 	Fields []string `json:"fields,omitempty"`
+	// Filter is a string, an array of strings or a filter.Expression, the documents are then
+	// fetched with a POST request and the attributes it uses must be filterable
+	Filter interface{} `json:"filter,omitempty"`
 }
 
 type DocumentsResult struct {
@@ -1637,7 +1637,7 @@ func (v *Stats) UnmarshalJSON(data []byte) error {
 func (v *Stats) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo12(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(in *jlexer.Lexer, out *Settings) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(in *jlexer.Lexer, out *SimilarDocumentsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1656,176 +1656,492 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "rankingRules":
+		case "hits":
 			if in.IsNull() {
 				in.Skip()
-				out.RankingRules = nil
+				out.Hits = nil
 			} else {
 				in.Delim('[')
-				if out.RankingRules == nil {
+				if out.Hits == nil {
 					if !in.IsDelim(']') {
-						out.RankingRules = make([]string, 0, 4)
+						out.Hits = make([]interface{}, 0, 4)
 					} else {
-						out.RankingRules = []string{}
+						out.Hits = []interface{}{}
 					}
 				} else {
-					out.RankingRules = (out.RankingRules)[:0]
+					out.Hits = (out.Hits)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v32 string
-					v32 = string(in.String())
-					out.RankingRules = append(out.RankingRules, v32)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "distinctAttribute":
-			if in.IsNull() {
-				in.Skip()
-				out.DistinctAttribute = nil
-			} else {
-				if out.DistinctAttribute == nil {
-					out.DistinctAttribute = new(string)
-				}
-				*out.DistinctAttribute = string(in.String())
-			}
-		case "searchableAttributes":
-			if in.IsNull() {
-				in.Skip()
-				out.SearchableAttributes = nil
-			} else {
-				in.Delim('[')
-				if out.SearchableAttributes == nil {
-					if !in.IsDelim(']') {
-						out.SearchableAttributes = make([]string, 0, 4)
+					var v32 interface{}
+					if m, ok := v32.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v32.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
 					} else {
-						out.SearchableAttributes = []string{}
+						v32 = in.Interface()
 					}
-				} else {
-					out.SearchableAttributes = (out.SearchableAttributes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v33 string
-					v33 = string(in.String())
-					out.SearchableAttributes = append(out.SearchableAttributes, v33)
+					out.Hits = append(out.Hits, v32)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "displayedAttributes":
-			if in.IsNull() {
-				in.Skip()
-				out.DisplayedAttributes = nil
-			} else {
-				in.Delim('[')
-				if out.DisplayedAttributes == nil {
-					if !in.IsDelim(']') {
-						out.DisplayedAttributes = make([]string, 0, 4)
-					} else {
-						out.DisplayedAttributes = []string{}
-					}
-				} else {
-					out.DisplayedAttributes = (out.DisplayedAttributes)[:0]
+		case "id":
+			out.ID = string(in.String())
+		case "estimatedTotalHits":
+			out.EstimatedTotalHits = int64(in.Int64())
+		case "offset":
+			out.Offset = int64(in.Int64())
+		case "limit":
+			out.Limit = int64(in.Int64())
+		case "processingTimeMs":
+			out.ProcessingTimeMs = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo13(out *jwriter.Writer, in SimilarDocumentsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"hits\":"
+		out.RawString(prefix[1:])
+		if in.Hits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v33, v34 := range in.Hits {
+				if v33 > 0 {
+					out.RawByte(',')
 				}
-				for !in.IsDelim(']') {
-					var v34 string
-					v34 = string(in.String())
-					out.DisplayedAttributes = append(out.DisplayedAttributes, v34)
-					in.WantComma()
+				if m, ok := v34.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v34.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v34))
 				}
-				in.Delim(']')
 			}
-		case "stopWords":
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if in.EstimatedTotalHits != 0 {
+		const prefix string = ",\"estimatedTotalHits\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EstimatedTotalHits))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Offset))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Limit))
+	}
+	{
+		const prefix string = ",\"processingTimeMs\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ProcessingTimeMs))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SimilarDocumentsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SimilarDocumentsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SimilarDocumentsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SimilarDocumentsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo14(in *jlexer.Lexer, out *SimilarDocumentsQuery) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "embedder":
+			out.Embedder = string(in.String())
+		case "attributesToRetrieve":
 			if in.IsNull() {
 				in.Skip()
-				out.StopWords = nil
+				out.AttributesToRetrieve = nil
 			} else {
 				in.Delim('[')
-				if out.StopWords == nil {
+				if out.AttributesToRetrieve == nil {
 					if !in.IsDelim(']') {
-						out.StopWords = make([]string, 0, 4)
+						out.AttributesToRetrieve = make([]string, 0, 4)
 					} else {
-						out.StopWords = []string{}
+						out.AttributesToRetrieve = []string{}
 					}
 				} else {
-					out.StopWords = (out.StopWords)[:0]
+					out.AttributesToRetrieve = (out.AttributesToRetrieve)[:0]
 				}
 				for !in.IsDelim(']') {
 					var v35 string
 					v35 = string(in.String())
-					out.StopWords = append(out.StopWords, v35)
+					out.AttributesToRetrieve = append(out.AttributesToRetrieve, v35)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "synonyms":
-			if in.IsNull() {
-				in.Skip()
+		case "offset":
+			out.Offset = int64(in.Int64())
+		case "limit":
+			out.Limit = int64(in.Int64())
+		case "filter":
+			if m, ok := out.Filter.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Filter.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
 			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.Synonyms = make(map[string][]string)
-				} else {
-					out.Synonyms = nil
-				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v36 []string
-					if in.IsNull() {
-						in.Skip()
-						v36 = nil
-					} else {
-						in.Delim('[')
-						if v36 == nil {
-							if !in.IsDelim(']') {
-								v36 = make([]string, 0, 4)
-							} else {
-								v36 = []string{}
-							}
-						} else {
-							v36 = (v36)[:0]
-						}
-						for !in.IsDelim(']') {
-							var v37 string
-							v37 = string(in.String())
-							v36 = append(v36, v37)
-							in.WantComma()
-						}
-						in.Delim(']')
-					}
-					(out.Synonyms)[key] = v36
-					in.WantComma()
-				}
-				in.Delim('}')
+				out.Filter = in.Interface()
 			}
-		case "filterableAttributes":
-			if in.IsNull() {
-				in.Skip()
-				out.FilterableAttributes = nil
-			} else {
-				in.Delim('[')
-				if out.FilterableAttributes == nil {
-					if !in.IsDelim(']') {
-						out.FilterableAttributes = make([]string, 0, 4)
-					} else {
-						out.FilterableAttributes = []string{}
-					}
-				} else {
-					out.FilterableAttributes = (out.FilterableAttributes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v38 string
-					v38 = string(in.String())
-					out.FilterableAttributes = append(out.FilterableAttributes, v38)
-					in.WantComma()
+		case "showRankingScore":
+			out.ShowRankingScore = bool(in.Bool())
+		case "showRankingScoreDetails":
+			out.ShowRankingScoreDetails = bool(in.Bool())
+		case "rankingScoreThreshold":
+			out.RankingScoreThreshold = float64(in.Float64())
+		case "retrieveVectors":
+			out.RetrieveVectors = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo14(out *jwriter.Writer, in SimilarDocumentsQuery) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Embedder != "" {
+		const prefix string = ",\"embedder\":"
+		out.RawString(prefix)
+		out.String(string(in.Embedder))
+	}
+	if len(in.AttributesToRetrieve) != 0 {
+		const prefix string = ",\"attributesToRetrieve\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v36, v37 := range in.AttributesToRetrieve {
+				if v36 > 0 {
+					out.RawByte(',')
 				}
-				in.Delim(']')
+				out.String(string(v37))
 			}
-		case "sortableAttributes":
-			if in.IsNull() {
-				in.Skip()
-				out.SortableAttributes = nil
+			out.RawByte(']')
+		}
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Offset))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Limit))
+	}
+	if in.Filter != nil {
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix)
+		if m, ok := in.Filter.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Filter.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Filter))
+		}
+	}
+	if in.ShowRankingScore {
+		const prefix string = ",\"showRankingScore\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowRankingScore))
+	}
+	if in.ShowRankingScoreDetails {
+		const prefix string = ",\"showRankingScoreDetails\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowRankingScoreDetails))
+	}
+	if in.RankingScoreThreshold != 0 {
+		const prefix string = ",\"rankingScoreThreshold\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RankingScoreThreshold))
+	}
+	if in.RetrieveVectors {
+		const prefix string = ",\"retrieveVectors\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RetrieveVectors))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SimilarDocumentsQuery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SimilarDocumentsQuery) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SimilarDocumentsQuery) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SimilarDocumentsQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo14(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo15(in *jlexer.Lexer, out *Settings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rankingRules":
+			if in.IsNull() {
+				in.Skip()
+				out.RankingRules = nil
+			} else {
+				in.Delim('[')
+				if out.RankingRules == nil {
+					if !in.IsDelim(']') {
+						out.RankingRules = make([]string, 0, 4)
+					} else {
+						out.RankingRules = []string{}
+					}
+				} else {
+					out.RankingRules = (out.RankingRules)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v38 string
+					v38 = string(in.String())
+					out.RankingRules = append(out.RankingRules, v38)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "distinctAttribute":
+			if in.IsNull() {
+				in.Skip()
+				out.DistinctAttribute = nil
+			} else {
+				if out.DistinctAttribute == nil {
+					out.DistinctAttribute = new(string)
+				}
+				*out.DistinctAttribute = string(in.String())
+			}
+		case "searchableAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.SearchableAttributes = nil
+			} else {
+				in.Delim('[')
+				if out.SearchableAttributes == nil {
+					if !in.IsDelim(']') {
+						out.SearchableAttributes = make([]string, 0, 4)
+					} else {
+						out.SearchableAttributes = []string{}
+					}
+				} else {
+					out.SearchableAttributes = (out.SearchableAttributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v39 string
+					v39 = string(in.String())
+					out.SearchableAttributes = append(out.SearchableAttributes, v39)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "displayedAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.DisplayedAttributes = nil
+			} else {
+				in.Delim('[')
+				if out.DisplayedAttributes == nil {
+					if !in.IsDelim(']') {
+						out.DisplayedAttributes = make([]string, 0, 4)
+					} else {
+						out.DisplayedAttributes = []string{}
+					}
+				} else {
+					out.DisplayedAttributes = (out.DisplayedAttributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 string
+					v40 = string(in.String())
+					out.DisplayedAttributes = append(out.DisplayedAttributes, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "stopWords":
+			if in.IsNull() {
+				in.Skip()
+				out.StopWords = nil
+			} else {
+				in.Delim('[')
+				if out.StopWords == nil {
+					if !in.IsDelim(']') {
+						out.StopWords = make([]string, 0, 4)
+					} else {
+						out.StopWords = []string{}
+					}
+				} else {
+					out.StopWords = (out.StopWords)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v41 string
+					v41 = string(in.String())
+					out.StopWords = append(out.StopWords, v41)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "synonyms":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Synonyms = make(map[string][]string)
+				} else {
+					out.Synonyms = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v42 []string
+					if in.IsNull() {
+						in.Skip()
+						v42 = nil
+					} else {
+						in.Delim('[')
+						if v42 == nil {
+							if !in.IsDelim(']') {
+								v42 = make([]string, 0, 4)
+							} else {
+								v42 = []string{}
+							}
+						} else {
+							v42 = (v42)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v43 string
+							v43 = string(in.String())
+							v42 = append(v42, v43)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					(out.Synonyms)[key] = v42
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "filterableAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.FilterableAttributes = nil
+			} else {
+				in.Delim('[')
+				if out.FilterableAttributes == nil {
+					if !in.IsDelim(']') {
+						out.FilterableAttributes = make([]string, 0, 4)
+					} else {
+						out.FilterableAttributes = []string{}
+					}
+				} else {
+					out.FilterableAttributes = (out.FilterableAttributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 string
+					v44 = string(in.String())
+					out.FilterableAttributes = append(out.FilterableAttributes, v44)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sortableAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.SortableAttributes = nil
 			} else {
 				in.Delim('[')
 				if out.SortableAttributes == nil {
@@ -1838,9 +2154,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(in *jlexer.Lexer,
 					out.SortableAttributes = (out.SortableAttributes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v39 string
-					v39 = string(in.String())
-					out.SortableAttributes = append(out.SortableAttributes, v39)
+					var v45 string
+					v45 = string(in.String())
+					out.SortableAttributes = append(out.SortableAttributes, v45)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1875,6 +2191,2404 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(in *jlexer.Lexer,
 				}
 				(*out.Faceting).UnmarshalEasyJSON(in)
 			}
+		case "embedders":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Embedders = make(map[string]Embedder)
+				} else {
+					out.Embedders = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v46 Embedder
+					(v46).UnmarshalEasyJSON(in)
+					(out.Embedders)[key] = v46
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "proximityPrecision":
+			out.ProximityPrecision = ProximityPrecision(in.String())
+		case "separatorTokens":
+			if in.IsNull() {
+				in.Skip()
+				out.SeparatorTokens = nil
+			} else {
+				in.Delim('[')
+				if out.SeparatorTokens == nil {
+					if !in.IsDelim(']') {
+						out.SeparatorTokens = make([]string, 0, 4)
+					} else {
+						out.SeparatorTokens = []string{}
+					}
+				} else {
+					out.SeparatorTokens = (out.SeparatorTokens)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v47 string
+					v47 = string(in.String())
+					out.SeparatorTokens = append(out.SeparatorTokens, v47)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nonSeparatorTokens":
+			if in.IsNull() {
+				in.Skip()
+				out.NonSeparatorTokens = nil
+			} else {
+				in.Delim('[')
+				if out.NonSeparatorTokens == nil {
+					if !in.IsDelim(']') {
+						out.NonSeparatorTokens = make([]string, 0, 4)
+					} else {
+						out.NonSeparatorTokens = []string{}
+					}
+				} else {
+					out.NonSeparatorTokens = (out.NonSeparatorTokens)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v48 string
+					v48 = string(in.String())
+					out.NonSeparatorTokens = append(out.NonSeparatorTokens, v48)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "dictionary":
+			if in.IsNull() {
+				in.Skip()
+				out.Dictionary = nil
+			} else {
+				in.Delim('[')
+				if out.Dictionary == nil {
+					if !in.IsDelim(']') {
+						out.Dictionary = make([]string, 0, 4)
+					} else {
+						out.Dictionary = []string{}
+					}
+				} else {
+					out.Dictionary = (out.Dictionary)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 string
+					v49 = string(in.String())
+					out.Dictionary = append(out.Dictionary, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "searchCutoffMs":
+			out.SearchCutoffMs = int64(in.Int64())
+		case "localizedAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.LocalizedAttributes = nil
+			} else {
+				in.Delim('[')
+				if out.LocalizedAttributes == nil {
+					if !in.IsDelim(']') {
+						out.LocalizedAttributes = make([]LocalizedAttributes, 0, 1)
+					} else {
+						out.LocalizedAttributes = []LocalizedAttributes{}
+					}
+				} else {
+					out.LocalizedAttributes = (out.LocalizedAttributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v50 LocalizedAttributes
+					(v50).UnmarshalEasyJSON(in)
+					out.LocalizedAttributes = append(out.LocalizedAttributes, v50)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "facetSearch":
+			if in.IsNull() {
+				in.Skip()
+				out.FacetSearch = nil
+			} else {
+				if out.FacetSearch == nil {
+					out.FacetSearch = new(bool)
+				}
+				*out.FacetSearch = bool(in.Bool())
+			}
+		case "prefixSearch":
+			out.PrefixSearch = PrefixSearch(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo15(out *jwriter.Writer, in Settings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.RankingRules) != 0 {
+		const prefix string = ",\"rankingRules\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v51, v52 := range in.RankingRules {
+				if v51 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v52))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.DistinctAttribute != nil {
+		const prefix string = ",\"distinctAttribute\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(*in.DistinctAttribute))
+	}
+	if len(in.SearchableAttributes) != 0 {
+		const prefix string = ",\"searchableAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v53, v54 := range in.SearchableAttributes {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v54))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.DisplayedAttributes) != 0 {
+		const prefix string = ",\"displayedAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v55, v56 := range in.DisplayedAttributes {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v56))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.StopWords) != 0 {
+		const prefix string = ",\"stopWords\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v57, v58 := range in.StopWords {
+				if v57 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v58))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Synonyms) != 0 {
+		const prefix string = ",\"synonyms\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v59First := true
+			for v59Name, v59Value := range in.Synonyms {
+				if v59First {
+					v59First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v59Name))
+				out.RawByte(':')
+				if v59Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v60, v61 := range v59Value {
+						if v60 > 0 {
+							out.RawByte(',')
+						}
+						out.String(string(v61))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.FilterableAttributes) != 0 {
+		const prefix string = ",\"filterableAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v62, v63 := range in.FilterableAttributes {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v63))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.SortableAttributes) != 0 {
+		const prefix string = ",\"sortableAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v64, v65 := range in.SortableAttributes {
+				if v64 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v65))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.TypoTolerance != nil {
+		const prefix string = ",\"typoTolerance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.TypoTolerance).MarshalEasyJSON(out)
+	}
+	if in.Pagination != nil {
+		const prefix string = ",\"pagination\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Pagination).MarshalEasyJSON(out)
+	}
+	if in.Faceting != nil {
+		const prefix string = ",\"faceting\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Faceting).MarshalEasyJSON(out)
+	}
+	if len(in.Embedders) != 0 {
+		const prefix string = ",\"embedders\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v66First := true
+			for v66Name, v66Value := range in.Embedders {
+				if v66First {
+					v66First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v66Name))
+				out.RawByte(':')
+				(v66Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.ProximityPrecision != "" {
+		const prefix string = ",\"proximityPrecision\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ProximityPrecision))
+	}
+	if len(in.SeparatorTokens) != 0 {
+		const prefix string = ",\"separatorTokens\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v67, v68 := range in.SeparatorTokens {
+				if v67 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v68))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.NonSeparatorTokens) != 0 {
+		const prefix string = ",\"nonSeparatorTokens\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v69, v70 := range in.NonSeparatorTokens {
+				if v69 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v70))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Dictionary) != 0 {
+		const prefix string = ",\"dictionary\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v71, v72 := range in.Dictionary {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v72))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.SearchCutoffMs != 0 {
+		const prefix string = ",\"searchCutoffMs\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.SearchCutoffMs))
+	}
+	if len(in.LocalizedAttributes) != 0 {
+		const prefix string = ",\"localizedAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v73, v74 := range in.LocalizedAttributes {
+				if v73 > 0 {
+					out.RawByte(',')
+				}
+				(v74).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.FacetSearch != nil {
+		const prefix string = ",\"facetSearch\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(*in.FacetSearch))
+	}
+	if in.PrefixSearch != "" {
+		const prefix string = ",\"prefixSearch\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PrefixSearch))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Settings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Settings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Settings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Settings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo15(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo16(in *jlexer.Lexer, out *SearchResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "indexUid":
+			out.IndexUID = string(in.String())
+		case "hits":
+			if in.IsNull() {
+				in.Skip()
+				out.Hits = nil
+			} else {
+				in.Delim('[')
+				if out.Hits == nil {
+					if !in.IsDelim(']') {
+						out.Hits = make([]interface{}, 0, 4)
+					} else {
+						out.Hits = []interface{}{}
+					}
+				} else {
+					out.Hits = (out.Hits)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v75 interface{}
+					if m, ok := v75.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v75.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v75 = in.Interface()
+					}
+					out.Hits = append(out.Hits, v75)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "estimatedTotalHits":
+			out.EstimatedTotalHits = int64(in.Int64())
+		case "offset":
+			out.Offset = int64(in.Int64())
+		case "limit":
+			out.Limit = int64(in.Int64())
+		case "processingTimeMs":
+			out.ProcessingTimeMs = int64(in.Int64())
+		case "query":
+			out.Query = string(in.String())
+		case "facetDistribution":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FacetDistribution = make(map[string]map[string]int64)
+				} else {
+					out.FacetDistribution = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v76 map[string]int64
+					if in.IsNull() {
+						in.Skip()
+					} else {
+						in.Delim('{')
+						if !in.IsDelim('}') {
+							v76 = make(map[string]int64)
+						} else {
+							v76 = nil
+						}
+						for !in.IsDelim('}') {
+							key := string(in.String())
+							in.WantColon()
+							var v77 int64
+							v77 = int64(in.Int64())
+							(v76)[key] = v77
+							in.WantComma()
+						}
+						in.Delim('}')
+					}
+					(out.FacetDistribution)[key] = v76
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "facetStats":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.FacetStats = make(map[string]FacetStat)
+				} else {
+					out.FacetStats = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v78 FacetStat
+					(v78).UnmarshalEasyJSON(in)
+					(out.FacetStats)[key] = v78
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "totalHits":
+			out.TotalHits = int64(in.Int64())
+		case "hitsPerPage":
+			out.HitsPerPage = int64(in.Int64())
+		case "page":
+			out.Page = int64(in.Int64())
+		case "totalPages":
+			out.TotalPages = int64(in.Int64())
+		case "semanticHitCount":
+			out.SemanticHitCount = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo16(out *jwriter.Writer, in SearchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.IndexUID != "" {
+		const prefix string = ",\"indexUid\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.IndexUID))
+	}
+	{
+		const prefix string = ",\"hits\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Hits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v79, v80 := range in.Hits {
+				if v79 > 0 {
+					out.RawByte(',')
+				}
+				if m, ok := v80.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v80.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v80))
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.EstimatedTotalHits != 0 {
+		const prefix string = ",\"estimatedTotalHits\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EstimatedTotalHits))
+	}
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Offset))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Limit))
+	}
+	{
+		const prefix string = ",\"processingTimeMs\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ProcessingTimeMs))
+	}
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	if len(in.FacetDistribution) != 0 {
+		const prefix string = ",\"facetDistribution\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v81First := true
+			for v81Name, v81Value := range in.FacetDistribution {
+				if v81First {
+					v81First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v81Name))
+				out.RawByte(':')
+				if v81Value == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+					out.RawString(`null`)
+				} else {
+					out.RawByte('{')
+					v82First := true
+					for v82Name, v82Value := range v81Value {
+						if v82First {
+							v82First = false
+						} else {
+							out.RawByte(',')
+						}
+						out.String(string(v82Name))
+						out.RawByte(':')
+						out.Int64(int64(v82Value))
+					}
+					out.RawByte('}')
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.FacetStats) != 0 {
+		const prefix string = ",\"facetStats\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v83First := true
+			for v83Name, v83Value := range in.FacetStats {
+				if v83First {
+					v83First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v83Name))
+				out.RawByte(':')
+				(v83Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.TotalHits != 0 {
+		const prefix string = ",\"totalHits\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TotalHits))
+	}
+	if in.HitsPerPage != 0 {
+		const prefix string = ",\"hitsPerPage\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.HitsPerPage))
+	}
+	if in.Page != 0 {
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Page))
+	}
+	if in.TotalPages != 0 {
+		const prefix string = ",\"totalPages\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TotalPages))
+	}
+	if in.SemanticHitCount != 0 {
+		const prefix string = ",\"semanticHitCount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.SemanticHitCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo16(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo17(in *jlexer.Lexer, out *SearchRequestHybrid) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "embedder":
+			out.Embedder = string(in.String())
+		case "semanticRatio":
+			out.SemanticRatio = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo17(out *jwriter.Writer, in SearchRequestHybrid) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"embedder\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Embedder))
+	}
+	if in.SemanticRatio != 0 {
+		const prefix string = ",\"semanticRatio\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.SemanticRatio))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchRequestHybrid) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchRequestHybrid) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchRequestHybrid) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchRequestHybrid) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo17(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo18(in *jlexer.Lexer, out *SearchRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "IndexUID":
+			out.IndexUID = string(in.String())
+		case "Query":
+			out.Query = string(in.String())
+		case "Offset":
+			out.Offset = int64(in.Int64())
+		case "Limit":
+			out.Limit = int64(in.Int64())
+		case "AttributesToRetrieve":
+			if in.IsNull() {
+				in.Skip()
+				out.AttributesToRetrieve = nil
+			} else {
+				in.Delim('[')
+				if out.AttributesToRetrieve == nil {
+					if !in.IsDelim(']') {
+						out.AttributesToRetrieve = make([]string, 0, 4)
+					} else {
+						out.AttributesToRetrieve = []string{}
+					}
+				} else {
+					out.AttributesToRetrieve = (out.AttributesToRetrieve)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v84 string
+					v84 = string(in.String())
+					out.AttributesToRetrieve = append(out.AttributesToRetrieve, v84)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "AttributesToCrop":
+			if in.IsNull() {
+				in.Skip()
+				out.AttributesToCrop = nil
+			} else {
+				in.Delim('[')
+				if out.AttributesToCrop == nil {
+					if !in.IsDelim(']') {
+						out.AttributesToCrop = make([]string, 0, 4)
+					} else {
+						out.AttributesToCrop = []string{}
+					}
+				} else {
+					out.AttributesToCrop = (out.AttributesToCrop)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v85 string
+					v85 = string(in.String())
+					out.AttributesToCrop = append(out.AttributesToCrop, v85)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "CropLength":
+			out.CropLength = int64(in.Int64())
+		case "CropMarker":
+			out.CropMarker = string(in.String())
+		case "AttributesToHighlight":
+			if in.IsNull() {
+				in.Skip()
+				out.AttributesToHighlight = nil
+			} else {
+				in.Delim('[')
+				if out.AttributesToHighlight == nil {
+					if !in.IsDelim(']') {
+						out.AttributesToHighlight = make([]string, 0, 4)
+					} else {
+						out.AttributesToHighlight = []string{}
+					}
+				} else {
+					out.AttributesToHighlight = (out.AttributesToHighlight)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v86 string
+					v86 = string(in.String())
+					out.AttributesToHighlight = append(out.AttributesToHighlight, v86)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "HighlightPreTag":
+			out.HighlightPreTag = string(in.String())
+		case "HighlightPostTag":
+			out.HighlightPostTag = string(in.String())
+		case "MatchingStrategy":
+			out.MatchingStrategy = string(in.String())
+		case "Filter":
+			if m, ok := out.Filter.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Filter.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Filter = in.Interface()
+			}
+		case "ShowMatchesPosition":
+			out.ShowMatchesPosition = bool(in.Bool())
+		case "Facets":
+			if in.IsNull() {
+				in.Skip()
+				out.Facets = nil
+			} else {
+				in.Delim('[')
+				if out.Facets == nil {
+					if !in.IsDelim(']') {
+						out.Facets = make([]string, 0, 4)
+					} else {
+						out.Facets = []string{}
+					}
+				} else {
+					out.Facets = (out.Facets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v87 string
+					v87 = string(in.String())
+					out.Facets = append(out.Facets, v87)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "PlaceholderSearch":
+			out.PlaceholderSearch = bool(in.Bool())
+		case "Sort":
+			if in.IsNull() {
+				in.Skip()
+				out.Sort = nil
+			} else {
+				in.Delim('[')
+				if out.Sort == nil {
+					if !in.IsDelim(']') {
+						out.Sort = make([]string, 0, 4)
+					} else {
+						out.Sort = []string{}
+					}
+				} else {
+					out.Sort = (out.Sort)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 string
+					v88 = string(in.String())
+					out.Sort = append(out.Sort, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "HitsPerPage":
+			out.HitsPerPage = int64(in.Int64())
+		case "Page":
+			out.Page = int64(in.Int64())
+		case "FederationOptions":
+			if in.IsNull() {
+				in.Skip()
+				out.FederationOptions = nil
+			} else {
+				if out.FederationOptions == nil {
+					out.FederationOptions = new(FederationOptions)
+				}
+				(*out.FederationOptions).UnmarshalEasyJSON(in)
+			}
+		case "ShowRankingScore":
+			out.ShowRankingScore = bool(in.Bool())
+		case "ShowRankingScoreDetails":
+			out.ShowRankingScoreDetails = bool(in.Bool())
+		case "RankingScoreThreshold":
+			out.RankingScoreThreshold = float64(in.Float64())
+		case "Vector":
+			if in.IsNull() {
+				in.Skip()
+				out.Vector = nil
+			} else {
+				in.Delim('[')
+				if out.Vector == nil {
+					if !in.IsDelim(']') {
+						out.Vector = make([]float32, 0, 16)
+					} else {
+						out.Vector = []float32{}
+					}
+				} else {
+					out.Vector = (out.Vector)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v89 float32
+					v89 = float32(in.Float32())
+					out.Vector = append(out.Vector, v89)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Hybrid":
+			if in.IsNull() {
+				in.Skip()
+				out.Hybrid = nil
+			} else {
+				if out.Hybrid == nil {
+					out.Hybrid = new(SearchRequestHybrid)
+				}
+				(*out.Hybrid).UnmarshalEasyJSON(in)
+			}
+		case "RetrieveVectors":
+			out.RetrieveVectors = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo18(out *jwriter.Writer, in SearchRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"IndexUID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.IndexUID))
+	}
+	{
+		const prefix string = ",\"Query\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Offset))
+	}
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Limit))
+	}
+	{
+		const prefix string = ",\"AttributesToRetrieve\":"
+		out.RawString(prefix)
+		if in.AttributesToRetrieve == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v90, v91 := range in.AttributesToRetrieve {
+				if v90 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v91))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"AttributesToCrop\":"
+		out.RawString(prefix)
+		if in.AttributesToCrop == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v92, v93 := range in.AttributesToCrop {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v93))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"CropLength\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CropLength))
+	}
+	{
+		const prefix string = ",\"CropMarker\":"
+		out.RawString(prefix)
+		out.String(string(in.CropMarker))
+	}
+	{
+		const prefix string = ",\"AttributesToHighlight\":"
+		out.RawString(prefix)
+		if in.AttributesToHighlight == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v94, v95 := range in.AttributesToHighlight {
+				if v94 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v95))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"HighlightPreTag\":"
+		out.RawString(prefix)
+		out.String(string(in.HighlightPreTag))
+	}
+	{
+		const prefix string = ",\"HighlightPostTag\":"
+		out.RawString(prefix)
+		out.String(string(in.HighlightPostTag))
+	}
+	{
+		const prefix string = ",\"MatchingStrategy\":"
+		out.RawString(prefix)
+		out.String(string(in.MatchingStrategy))
+	}
+	{
+		const prefix string = ",\"Filter\":"
+		out.RawString(prefix)
+		if m, ok := in.Filter.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Filter.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Filter))
+		}
+	}
+	{
+		const prefix string = ",\"ShowMatchesPosition\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowMatchesPosition))
+	}
+	{
+		const prefix string = ",\"Facets\":"
+		out.RawString(prefix)
+		if in.Facets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v96, v97 := range in.Facets {
+				if v96 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v97))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"PlaceholderSearch\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.PlaceholderSearch))
+	}
+	{
+		const prefix string = ",\"Sort\":"
+		out.RawString(prefix)
+		if in.Sort == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v98, v99 := range in.Sort {
+				if v98 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v99))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"HitsPerPage\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.HitsPerPage))
+	}
+	{
+		const prefix string = ",\"Page\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Page))
+	}
+	{
+		const prefix string = ",\"FederationOptions\":"
+		out.RawString(prefix)
+		if in.FederationOptions == nil {
+			out.RawString("null")
+		} else {
+			(*in.FederationOptions).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"ShowRankingScore\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowRankingScore))
+	}
+	{
+		const prefix string = ",\"ShowRankingScoreDetails\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ShowRankingScoreDetails))
+	}
+	{
+		const prefix string = ",\"RankingScoreThreshold\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RankingScoreThreshold))
+	}
+	{
+		const prefix string = ",\"Vector\":"
+		out.RawString(prefix)
+		if in.Vector == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v100, v101 := range in.Vector {
+				if v100 > 0 {
+					out.RawByte(',')
+				}
+				out.Float32(float32(v101))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Hybrid\":"
+		out.RawString(prefix)
+		if in.Hybrid == nil {
+			out.RawString("null")
+		} else {
+			(*in.Hybrid).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"RetrieveVectors\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RetrieveVectors))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo18(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo19(in *jlexer.Lexer, out *RankingScoreDetail) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "order":
+			out.Order = int64(in.Int64())
+		case "score":
+			out.Score = float64(in.Float64())
+		case "matchingWords":
+			out.MatchingWords = int64(in.Int64())
+		case "maxMatchingWords":
+			out.MaxMatchingWords = int64(in.Int64())
+		case "typoCount":
+			out.TypoCount = int64(in.Int64())
+		case "maxTypoCount":
+			out.MaxTypoCount = int64(in.Int64())
+		case "attributeRankingOrderScore":
+			out.AttributeRankingOrderScore = float64(in.Float64())
+		case "queryWordDistanceScore":
+			out.QueryWordDistanceScore = float64(in.Float64())
+		case "matchType":
+			out.MatchType = string(in.String())
+		case "value":
+			if m, ok := out.Value.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Value.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Value = in.Interface()
+			}
+		case "distance":
+			out.Distance = float64(in.Float64())
+		case "similarity":
+			out.Similarity = float64(in.Float64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo19(out *jwriter.Writer, in RankingScoreDetail) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"order\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Order))
+	}
+	if in.Score != 0 {
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Score))
+	}
+	if in.MatchingWords != 0 {
+		const prefix string = ",\"matchingWords\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MatchingWords))
+	}
+	if in.MaxMatchingWords != 0 {
+		const prefix string = ",\"maxMatchingWords\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxMatchingWords))
+	}
+	if in.TypoCount != 0 {
+		const prefix string = ",\"typoCount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TypoCount))
+	}
+	if in.MaxTypoCount != 0 {
+		const prefix string = ",\"maxTypoCount\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxTypoCount))
+	}
+	if in.AttributeRankingOrderScore != 0 {
+		const prefix string = ",\"attributeRankingOrderScore\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AttributeRankingOrderScore))
+	}
+	if in.QueryWordDistanceScore != 0 {
+		const prefix string = ",\"queryWordDistanceScore\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.QueryWordDistanceScore))
+	}
+	if in.MatchType != "" {
+		const prefix string = ",\"matchType\":"
+		out.RawString(prefix)
+		out.String(string(in.MatchType))
+	}
+	if in.Value != nil {
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		if m, ok := in.Value.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Value.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Value))
+		}
+	}
+	if in.Distance != 0 {
+		const prefix string = ",\"distance\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Distance))
+	}
+	if in.Similarity != 0 {
+		const prefix string = ",\"similarity\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Similarity))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RankingScoreDetail) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RankingScoreDetail) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RankingScoreDetail) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RankingScoreDetail) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo19(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo20(in *jlexer.Lexer, out *Pagination) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "maxTotalHits":
+			out.MaxTotalHits = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo20(out *jwriter.Writer, in Pagination) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"maxTotalHits\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.MaxTotalHits))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Pagination) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Pagination) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Pagination) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Pagination) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo20(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo21(in *jlexer.Lexer, out *MultiSearchResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make([]SearchResponse, 0, 0)
+					} else {
+						out.Results = []SearchResponse{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v102 SearchResponse
+					(v102).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v102)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo21(out *jwriter.Writer, in MultiSearchResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v103, v104 := range in.Results {
+				if v103 > 0 {
+					out.RawByte(',')
+				}
+				(v104).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MultiSearchResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MultiSearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MultiSearchResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MultiSearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo21(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo22(in *jlexer.Lexer, out *MultiSearchRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "federation":
+			if in.IsNull() {
+				in.Skip()
+				out.Federation = nil
+			} else {
+				if out.Federation == nil {
+					out.Federation = new(MultiSearchFederation)
+				}
+				(*out.Federation).UnmarshalEasyJSON(in)
+			}
+		case "queries":
+			if in.IsNull() {
+				in.Skip()
+				out.Queries = nil
+			} else {
+				in.Delim('[')
+				if out.Queries == nil {
+					if !in.IsDelim(']') {
+						out.Queries = make([]SearchRequest, 0, 0)
+					} else {
+						out.Queries = []SearchRequest{}
+					}
+				} else {
+					out.Queries = (out.Queries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v105 SearchRequest
+					(v105).UnmarshalEasyJSON(in)
+					out.Queries = append(out.Queries, v105)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo22(out *jwriter.Writer, in MultiSearchRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Federation != nil {
+		const prefix string = ",\"federation\":"
+		first = false
+		out.RawString(prefix[1:])
+		(*in.Federation).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"queries\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Queries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v106, v107 := range in.Queries {
+				if v106 > 0 {
+					out.RawByte(',')
+				}
+				(v107).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MultiSearchRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MultiSearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MultiSearchRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MultiSearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo22(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo23(in *jlexer.Lexer, out *MultiSearchFederation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "offset":
+			out.Offset = int64(in.Int64())
+		case "limit":
+			out.Limit = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo23(out *jwriter.Writer, in MultiSearchFederation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Offset != 0 {
+		const prefix string = ",\"offset\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Offset))
+	}
+	if in.Limit != 0 {
+		const prefix string = ",\"limit\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MultiSearchFederation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MultiSearchFederation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MultiSearchFederation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MultiSearchFederation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo23(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo24(in *jlexer.Lexer, out *MinWordSizeForTypos) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "oneTypo":
+			out.OneTypo = int64(in.Int64())
+		case "twoTypos":
+			out.TwoTypos = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo24(out *jwriter.Writer, in MinWordSizeForTypos) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.OneTypo != 0 {
+		const prefix string = ",\"oneTypo\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.OneTypo))
+	}
+	if in.TwoTypos != 0 {
+		const prefix string = ",\"twoTypos\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.TwoTypos))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MinWordSizeForTypos) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MinWordSizeForTypos) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MinWordSizeForTypos) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MinWordSizeForTypos) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo24(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo25(in *jlexer.Lexer, out *MatchPosition) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start":
+			out.Start = int64(in.Int64())
+		case "length":
+			out.Length = int64(in.Int64())
+		case "indices":
+			if in.IsNull() {
+				in.Skip()
+				out.Indices = nil
+			} else {
+				in.Delim('[')
+				if out.Indices == nil {
+					if !in.IsDelim(']') {
+						out.Indices = make([]int64, 0, 8)
+					} else {
+						out.Indices = []int64{}
+					}
+				} else {
+					out.Indices = (out.Indices)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v108 int64
+					v108 = int64(in.Int64())
+					out.Indices = append(out.Indices, v108)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo25(out *jwriter.Writer, in MatchPosition) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Start))
+	}
+	{
+		const prefix string = ",\"length\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Length))
+	}
+	if len(in.Indices) != 0 {
+		const prefix string = ",\"indices\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v109, v110 := range in.Indices {
+				if v109 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v110))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MatchPosition) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MatchPosition) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MatchPosition) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MatchPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo25(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo26(in *jlexer.Lexer, out *LocalizedAttributes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributePatterns":
+			if in.IsNull() {
+				in.Skip()
+				out.AttributePatterns = nil
+			} else {
+				in.Delim('[')
+				if out.AttributePatterns == nil {
+					if !in.IsDelim(']') {
+						out.AttributePatterns = make([]string, 0, 4)
+					} else {
+						out.AttributePatterns = []string{}
+					}
+				} else {
+					out.AttributePatterns = (out.AttributePatterns)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v111 string
+					v111 = string(in.String())
+					out.AttributePatterns = append(out.AttributePatterns, v111)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "locales":
+			if in.IsNull() {
+				in.Skip()
+				out.Locales = nil
+			} else {
+				in.Delim('[')
+				if out.Locales == nil {
+					if !in.IsDelim(']') {
+						out.Locales = make([]string, 0, 4)
+					} else {
+						out.Locales = []string{}
+					}
+				} else {
+					out.Locales = (out.Locales)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v112 string
+					v112 = string(in.String())
+					out.Locales = append(out.Locales, v112)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo26(out *jwriter.Writer, in LocalizedAttributes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributePatterns\":"
+		out.RawString(prefix[1:])
+		if in.AttributePatterns == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v113, v114 := range in.AttributePatterns {
+				if v113 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v114))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"locales\":"
+		out.RawString(prefix)
+		if in.Locales == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v115, v116 := range in.Locales {
+				if v115 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v116))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LocalizedAttributes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LocalizedAttributes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LocalizedAttributes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LocalizedAttributes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo26(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo27(in *jlexer.Lexer, out *KeysResults) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make([]Key, 0, 0)
+					} else {
+						out.Results = []Key{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v117 Key
+					(v117).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v117)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "offset":
+			out.Offset = int64(in.Int64())
+		case "limit":
+			out.Limit = int64(in.Int64())
+		case "total":
+			out.Total = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo27(out *jwriter.Writer, in KeysResults) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v118, v119 := range in.Results {
+				if v118 > 0 {
+					out.RawByte(',')
+				}
+				(v119).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Offset))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Limit))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v KeysResults) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v KeysResults) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *KeysResults) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *KeysResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo27(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo28(in *jlexer.Lexer, out *KeysQuery) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Limit":
+			out.Limit = int64(in.Int64())
+		case "Offset":
+			out.Offset = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo28(out *jwriter.Writer, in KeysQuery) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Limit\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Offset))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v KeysQuery) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v KeysQuery) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *KeysQuery) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *KeysQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo28(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo29(in *jlexer.Lexer, out *KeyUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1885,222 +4599,53 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo13(out *jwriter.Writer, in Settings) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo29(out *jwriter.Writer, in KeyUpdate) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if len(in.RankingRules) != 0 {
-		const prefix string = ",\"rankingRules\":"
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
 		first = false
 		out.RawString(prefix[1:])
-		{
-			out.RawByte('[')
-			for v40, v41 := range in.RankingRules {
-				if v40 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v41))
-			}
-			out.RawByte(']')
-		}
-	}
-	if in.DistinctAttribute != nil {
-		const prefix string = ",\"distinctAttribute\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(*in.DistinctAttribute))
-	}
-	if len(in.SearchableAttributes) != 0 {
-		const prefix string = ",\"searchableAttributes\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v42, v43 := range in.SearchableAttributes {
-				if v42 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v43))
-			}
-			out.RawByte(']')
-		}
-	}
-	if len(in.DisplayedAttributes) != 0 {
-		const prefix string = ",\"displayedAttributes\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v44, v45 := range in.DisplayedAttributes {
-				if v44 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v45))
-			}
-			out.RawByte(']')
-		}
-	}
-	if len(in.StopWords) != 0 {
-		const prefix string = ",\"stopWords\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v46, v47 := range in.StopWords {
-				if v46 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v47))
-			}
-			out.RawByte(']')
-		}
-	}
-	if len(in.Synonyms) != 0 {
-		const prefix string = ",\"synonyms\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('{')
-			v48First := true
-			for v48Name, v48Value := range in.Synonyms {
-				if v48First {
-					v48First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v48Name))
-				out.RawByte(':')
-				if v48Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-					out.RawString("null")
-				} else {
-					out.RawByte('[')
-					for v49, v50 := range v48Value {
-						if v49 > 0 {
-							out.RawByte(',')
-						}
-						out.String(string(v50))
-					}
-					out.RawByte(']')
-				}
-			}
-			out.RawByte('}')
-		}
-	}
-	if len(in.FilterableAttributes) != 0 {
-		const prefix string = ",\"filterableAttributes\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v51, v52 := range in.FilterableAttributes {
-				if v51 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v52))
-			}
-			out.RawByte(']')
-		}
-	}
-	if len(in.SortableAttributes) != 0 {
-		const prefix string = ",\"sortableAttributes\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		{
-			out.RawByte('[')
-			for v53, v54 := range in.SortableAttributes {
-				if v53 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v54))
-			}
-			out.RawByte(']')
-		}
-	}
-	if in.TypoTolerance != nil {
-		const prefix string = ",\"typoTolerance\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.TypoTolerance).MarshalEasyJSON(out)
-	}
-	if in.Pagination != nil {
-		const prefix string = ",\"pagination\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		(*in.Pagination).MarshalEasyJSON(out)
+		out.String(string(in.Name))
 	}
-	if in.Faceting != nil {
-		const prefix string = ",\"faceting\":"
+	if in.Description != "" {
+		const prefix string = ",\"description\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		(*in.Faceting).MarshalEasyJSON(out)
+		out.String(string(in.Description))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Settings) MarshalJSON() ([]byte, error) {
+func (v KeyUpdate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo13(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo29(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Settings) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo13(w, v)
+func (v KeyUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo29(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Settings) UnmarshalJSON(data []byte) error {
+func (v *KeyUpdate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo29(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Settings) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo13(l, v)
+func (v *KeyUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo29(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo14(in *jlexer.Lexer, out *SearchResponse) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo30(in *jlexer.Lexer, out *KeyParsed) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2119,61 +4664,76 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo14(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "hits":
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "uid":
+			out.UID = string(in.String())
+		case "actions":
 			if in.IsNull() {
 				in.Skip()
-				out.Hits = nil
+				out.Actions = nil
 			} else {
 				in.Delim('[')
-				if out.Hits == nil {
+				if out.Actions == nil {
 					if !in.IsDelim(']') {
-						out.Hits = make([]interface{}, 0, 4)
+						out.Actions = make([]string, 0, 4)
 					} else {
-						out.Hits = []interface{}{}
+						out.Actions = []string{}
 					}
 				} else {
-					out.Hits = (out.Hits)[:0]
+					out.Actions = (out.Actions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v55 interface{}
-					if m, ok := v55.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v55.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
+					var v120 string
+					v120 = string(in.String())
+					out.Actions = append(out.Actions, v120)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "indexes":
+			if in.IsNull() {
+				in.Skip()
+				out.Indexes = nil
+			} else {
+				in.Delim('[')
+				if out.Indexes == nil {
+					if !in.IsDelim(']') {
+						out.Indexes = make([]string, 0, 4)
 					} else {
-						v55 = in.Interface()
+						out.Indexes = []string{}
 					}
-					out.Hits = append(out.Hits, v55)
+				} else {
+					out.Indexes = (out.Indexes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v121 string
+					v121 = string(in.String())
+					out.Indexes = append(out.Indexes, v121)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "estimatedTotalHits":
-			out.EstimatedTotalHits = int64(in.Int64())
-		case "offset":
-			out.Offset = int64(in.Int64())
-		case "limit":
-			out.Limit = int64(in.Int64())
-		case "processingTimeMs":
-			out.ProcessingTimeMs = int64(in.Int64())
-		case "query":
-			out.Query = string(in.String())
-		case "facetDistribution":
-			if m, ok := out.FacetDistribution.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.FacetDistribution.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "expiresAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpiresAt = nil
 			} else {
-				out.FacetDistribution = in.Interface()
+				if out.ExpiresAt == nil {
+					out.ExpiresAt = new(string)
+				}
+				*out.ExpiresAt = string(in.String())
 			}
-		case "totalHits":
-			out.TotalHits = int64(in.Int64())
-		case "hitsPerPage":
-			out.HitsPerPage = int64(in.Int64())
-		case "page":
-			out.Page = int64(in.Int64())
-		case "totalPages":
-			out.TotalPages = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2184,115 +4744,99 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo14(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo14(out *jwriter.Writer, in SearchResponse) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo30(out *jwriter.Writer, in KeyParsed) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"hits\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		if in.Hits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	if in.UID != "" {
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix)
+		out.String(string(in.UID))
+	}
+	if len(in.Actions) != 0 {
+		const prefix string = ",\"actions\":"
+		out.RawString(prefix)
+		{
 			out.RawByte('[')
-			for v56, v57 := range in.Hits {
-				if v56 > 0 {
+			for v122, v123 := range in.Actions {
+				if v122 > 0 {
 					out.RawByte(',')
 				}
-				if m, ok := v57.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v57.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v57))
-				}
+				out.String(string(v123))
 			}
 			out.RawByte(']')
 		}
 	}
-	if in.EstimatedTotalHits != 0 {
-		const prefix string = ",\"estimatedTotalHits\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.EstimatedTotalHits))
-	}
-	if in.Offset != 0 {
-		const prefix string = ",\"offset\":"
+	if len(in.Indexes) != 0 {
+		const prefix string = ",\"indexes\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Offset))
+		{
+			out.RawByte('[')
+			for v124, v125 := range in.Indexes {
+				if v124 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v125))
+			}
+			out.RawByte(']')
+		}
 	}
-	if in.Limit != 0 {
-		const prefix string = ",\"limit\":"
+	if true {
+		const prefix string = ",\"createdAt\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Limit))
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
-	{
-		const prefix string = ",\"processingTimeMs\":"
+	if true {
+		const prefix string = ",\"updatedAt\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.ProcessingTimeMs))
+		out.Raw((in.UpdatedAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"query\":"
-		out.RawString(prefix)
-		out.String(string(in.Query))
-	}
-	if in.FacetDistribution != nil {
-		const prefix string = ",\"facetDistribution\":"
+		const prefix string = ",\"expiresAt\":"
 		out.RawString(prefix)
-		if m, ok := in.FacetDistribution.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.FacetDistribution.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
+		if in.ExpiresAt == nil {
+			out.RawString("null")
 		} else {
-			out.Raw(json.Marshal(in.FacetDistribution))
+			out.String(string(*in.ExpiresAt))
 		}
 	}
-	if in.TotalHits != 0 {
-		const prefix string = ",\"totalHits\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.TotalHits))
-	}
-	if in.HitsPerPage != 0 {
-		const prefix string = ",\"hitsPerPage\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.HitsPerPage))
-	}
-	if in.Page != 0 {
-		const prefix string = ",\"page\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Page))
-	}
-	if in.TotalPages != 0 {
-		const prefix string = ",\"totalPages\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.TotalPages))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SearchResponse) MarshalJSON() ([]byte, error) {
+func (v KeyParsed) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo14(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo14(w, v)
+func (v KeyParsed) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SearchResponse) UnmarshalJSON(data []byte) error {
+func (v *KeyParsed) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo14(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo14(l, v)
+func (v *KeyParsed) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo30(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo15(in *jlexer.Lexer, out *SearchRequest) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer, out *Key) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2311,151 +4855,72 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo15(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "Offset":
-			out.Offset = int64(in.Int64())
-		case "Limit":
-			out.Limit = int64(in.Int64())
-		case "AttributesToRetrieve":
-			if in.IsNull() {
-				in.Skip()
-				out.AttributesToRetrieve = nil
-			} else {
-				in.Delim('[')
-				if out.AttributesToRetrieve == nil {
-					if !in.IsDelim(']') {
-						out.AttributesToRetrieve = make([]string, 0, 4)
-					} else {
-						out.AttributesToRetrieve = []string{}
-					}
-				} else {
-					out.AttributesToRetrieve = (out.AttributesToRetrieve)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v58 string
-					v58 = string(in.String())
-					out.AttributesToRetrieve = append(out.AttributesToRetrieve, v58)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "AttributesToCrop":
-			if in.IsNull() {
-				in.Skip()
-				out.AttributesToCrop = nil
-			} else {
-				in.Delim('[')
-				if out.AttributesToCrop == nil {
-					if !in.IsDelim(']') {
-						out.AttributesToCrop = make([]string, 0, 4)
-					} else {
-						out.AttributesToCrop = []string{}
-					}
-				} else {
-					out.AttributesToCrop = (out.AttributesToCrop)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v59 string
-					v59 = string(in.String())
-					out.AttributesToCrop = append(out.AttributesToCrop, v59)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "CropLength":
-			out.CropLength = int64(in.Int64())
-		case "CropMarker":
-			out.CropMarker = string(in.String())
-		case "AttributesToHighlight":
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "key":
+			out.Key = string(in.String())
+		case "uid":
+			out.UID = string(in.String())
+		case "actions":
 			if in.IsNull() {
 				in.Skip()
-				out.AttributesToHighlight = nil
+				out.Actions = nil
 			} else {
 				in.Delim('[')
-				if out.AttributesToHighlight == nil {
+				if out.Actions == nil {
 					if !in.IsDelim(']') {
-						out.AttributesToHighlight = make([]string, 0, 4)
+						out.Actions = make([]string, 0, 4)
 					} else {
-						out.AttributesToHighlight = []string{}
+						out.Actions = []string{}
 					}
 				} else {
-					out.AttributesToHighlight = (out.AttributesToHighlight)[:0]
+					out.Actions = (out.Actions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v60 string
-					v60 = string(in.String())
-					out.AttributesToHighlight = append(out.AttributesToHighlight, v60)
+					var v126 string
+					v126 = string(in.String())
+					out.Actions = append(out.Actions, v126)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "HighlightPreTag":
-			out.HighlightPreTag = string(in.String())
-		case "HighlightPostTag":
-			out.HighlightPostTag = string(in.String())
-		case "MatchingStrategy":
-			out.MatchingStrategy = string(in.String())
-		case "Filter":
-			if m, ok := out.Filter.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Filter.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Filter = in.Interface()
-			}
-		case "ShowMatchesPosition":
-			out.ShowMatchesPosition = bool(in.Bool())
-		case "Facets":
+		case "indexes":
 			if in.IsNull() {
 				in.Skip()
-				out.Facets = nil
+				out.Indexes = nil
 			} else {
 				in.Delim('[')
-				if out.Facets == nil {
+				if out.Indexes == nil {
 					if !in.IsDelim(']') {
-						out.Facets = make([]string, 0, 4)
+						out.Indexes = make([]string, 0, 4)
 					} else {
-						out.Facets = []string{}
+						out.Indexes = []string{}
 					}
 				} else {
-					out.Facets = (out.Facets)[:0]
+					out.Indexes = (out.Indexes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v61 string
-					v61 = string(in.String())
-					out.Facets = append(out.Facets, v61)
+					var v127 string
+					v127 = string(in.String())
+					out.Indexes = append(out.Indexes, v127)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "PlaceholderSearch":
-			out.PlaceholderSearch = bool(in.Bool())
-		case "Sort":
-			if in.IsNull() {
-				in.Skip()
-				out.Sort = nil
-			} else {
-				in.Delim('[')
-				if out.Sort == nil {
-					if !in.IsDelim(']') {
-						out.Sort = make([]string, 0, 4)
-					} else {
-						out.Sort = []string{}
-					}
-				} else {
-					out.Sort = (out.Sort)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v62 string
-					v62 = string(in.String())
-					out.Sort = append(out.Sort, v62)
-					in.WantComma()
-				}
-				in.Delim(']')
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
 			}
-		case "HitsPerPage":
-			out.HitsPerPage = int64(in.Int64())
-		case "Page":
-			out.Page = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2466,183 +4931,219 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo15(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo15(out *jwriter.Writer, in SearchRequest) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writer, in Key) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Offset\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Offset))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"Limit\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Limit))
+		out.String(string(in.Description))
 	}
-	{
-		const prefix string = ",\"AttributesToRetrieve\":"
+	if in.Key != "" {
+		const prefix string = ",\"key\":"
 		out.RawString(prefix)
-		if in.AttributesToRetrieve == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v63, v64 := range in.AttributesToRetrieve {
-				if v63 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v64))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Key))
 	}
-	{
-		const prefix string = ",\"AttributesToCrop\":"
+	if in.UID != "" {
+		const prefix string = ",\"uid\":"
 		out.RawString(prefix)
-		if in.AttributesToCrop == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
+		out.String(string(in.UID))
+	}
+	if len(in.Actions) != 0 {
+		const prefix string = ",\"actions\":"
+		out.RawString(prefix)
+		{
 			out.RawByte('[')
-			for v65, v66 := range in.AttributesToCrop {
-				if v65 > 0 {
+			for v128, v129 := range in.Actions {
+				if v128 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v66))
+				out.String(string(v129))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"CropLength\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.CropLength))
-	}
-	{
-		const prefix string = ",\"CropMarker\":"
-		out.RawString(prefix)
-		out.String(string(in.CropMarker))
-	}
-	{
-		const prefix string = ",\"AttributesToHighlight\":"
+	if len(in.Indexes) != 0 {
+		const prefix string = ",\"indexes\":"
 		out.RawString(prefix)
-		if in.AttributesToHighlight == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
+		{
 			out.RawByte('[')
-			for v67, v68 := range in.AttributesToHighlight {
-				if v67 > 0 {
+			for v130, v131 := range in.Indexes {
+				if v130 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v68))
+				out.String(string(v131))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"HighlightPreTag\":"
+	if true {
+		const prefix string = ",\"createdAt\":"
 		out.RawString(prefix)
-		out.String(string(in.HighlightPreTag))
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
-	{
-		const prefix string = ",\"HighlightPostTag\":"
+	if true {
+		const prefix string = ",\"updatedAt\":"
 		out.RawString(prefix)
-		out.String(string(in.HighlightPostTag))
+		out.Raw((in.UpdatedAt).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"MatchingStrategy\":"
+		const prefix string = ",\"expiresAt\":"
 		out.RawString(prefix)
-		out.String(string(in.MatchingStrategy))
+		out.Raw((in.ExpiresAt).MarshalJSON())
 	}
-	{
-		const prefix string = ",\"Filter\":"
-		out.RawString(prefix)
-		if m, ok := in.Filter.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Filter.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Filter))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Key) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Key) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Key) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Key) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(in *jlexer.Lexer, out *IndexesResults) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"ShowMatchesPosition\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.ShowMatchesPosition))
-	}
-	{
-		const prefix string = ",\"Facets\":"
-		out.RawString(prefix)
-		if in.Facets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v69, v70 := range in.Facets {
-				if v69 > 0 {
-					out.RawByte(',')
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "results":
+			if in.IsNull() {
+				in.Skip()
+				out.Results = nil
+			} else {
+				in.Delim('[')
+				if out.Results == nil {
+					if !in.IsDelim(']') {
+						out.Results = make([]Index, 0, 0)
+					} else {
+						out.Results = []Index{}
+					}
+				} else {
+					out.Results = (out.Results)[:0]
 				}
-				out.String(string(v70))
+				for !in.IsDelim(']') {
+					var v132 Index
+					(v132).UnmarshalEasyJSON(in)
+					out.Results = append(out.Results, v132)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-			out.RawByte(']')
+		case "offset":
+			out.Offset = int64(in.Int64())
+		case "limit":
+			out.Limit = int64(in.Int64())
+		case "total":
+			out.Total = int64(in.Int64())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"PlaceholderSearch\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.PlaceholderSearch))
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(out *jwriter.Writer, in IndexesResults) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"Sort\":"
-		out.RawString(prefix)
-		if in.Sort == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		const prefix string = ",\"results\":"
+		out.RawString(prefix[1:])
+		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v71, v72 := range in.Sort {
-				if v71 > 0 {
+			for v133, v134 := range in.Results {
+				if v133 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v72))
+				(v134).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"HitsPerPage\":"
+		const prefix string = ",\"offset\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.HitsPerPage))
+		out.Int64(int64(in.Offset))
 	}
 	{
-		const prefix string = ",\"Page\":"
+		const prefix string = ",\"limit\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Page))
+		out.Int64(int64(in.Limit))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Total))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SearchRequest) MarshalJSON() ([]byte, error) {
+func (v IndexesResults) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo15(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo15(w, v)
+func (v IndexesResults) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SearchRequest) UnmarshalJSON(data []byte) error {
+func (v *IndexesResults) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo15(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo15(l, v)
+func (v *IndexesResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo16(in *jlexer.Lexer, out *Pagination) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo33(in *jlexer.Lexer, out *IndexesQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2661,8 +5162,10 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo16(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "maxTotalHits":
-			out.MaxTotalHits = int64(in.Int64())
+		case "Limit":
+			out.Limit = int64(in.Int64())
+		case "Offset":
+			out.Offset = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2673,42 +5176,47 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo16(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo16(out *jwriter.Writer, in Pagination) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo33(out *jwriter.Writer, in IndexesQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"maxTotalHits\":"
+		const prefix string = ",\"Limit\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.MaxTotalHits))
+		out.Int64(int64(in.Limit))
+	}
+	{
+		const prefix string = ",\"Offset\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Offset))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Pagination) MarshalJSON() ([]byte, error) {
+func (v IndexesQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo16(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Pagination) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo16(w, v)
+func (v IndexesQuery) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Pagination) UnmarshalJSON(data []byte) error {
+func (v *IndexesQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo16(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Pagination) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo16(l, v)
+func (v *IndexesQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo33(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo17(in *jlexer.Lexer, out *MinWordSizeForTypos) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo34(in *jlexer.Lexer, out *Index) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2727,10 +5235,18 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo17(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "oneTypo":
-			out.OneTypo = int64(in.Int64())
-		case "twoTypos":
-			out.TwoTypos = int64(in.Int64())
+		case "uid":
+			out.UID = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "primaryKey":
+			out.PrimaryKey = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2741,53 +5257,57 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo17(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo17(out *jwriter.Writer, in MinWordSizeForTypos) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo34(out *jwriter.Writer, in Index) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.OneTypo != 0 {
-		const prefix string = ",\"oneTypo\":"
-		first = false
+	{
+		const prefix string = ",\"uid\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.OneTypo))
+		out.String(string(in.UID))
 	}
-	if in.TwoTypos != 0 {
-		const prefix string = ",\"twoTypos\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int64(int64(in.TwoTypos))
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	if in.PrimaryKey != "" {
+		const prefix string = ",\"primaryKey\":"
+		out.RawString(prefix)
+		out.String(string(in.PrimaryKey))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MinWordSizeForTypos) MarshalJSON() ([]byte, error) {
+func (v Index) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo17(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MinWordSizeForTypos) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo17(w, v)
+func (v Index) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MinWordSizeForTypos) UnmarshalJSON(data []byte) error {
+func (v *Index) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo17(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MinWordSizeForTypos) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo17(l, v)
+func (v *Index) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo34(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo18(in *jlexer.Lexer, out *KeysResults) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(in *jlexer.Lexer, out *HitFederation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2806,35 +5326,12 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo18(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "results":
-			if in.IsNull() {
-				in.Skip()
-				out.Results = nil
-			} else {
-				in.Delim('[')
-				if out.Results == nil {
-					if !in.IsDelim(']') {
-						out.Results = make([]Key, 0, 0)
-					} else {
-						out.Results = []Key{}
-					}
-				} else {
-					out.Results = (out.Results)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v73 Key
-					(v73).UnmarshalEasyJSON(in)
-					out.Results = append(out.Results, v73)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "offset":
-			out.Offset = int64(in.Int64())
-		case "limit":
-			out.Limit = int64(in.Int64())
-		case "total":
-			out.Total = int64(in.Int64())
+		case "indexUid":
+			out.IndexUID = string(in.String())
+		case "queriesPosition":
+			out.QueriesPosition = int64(in.Int64())
+		case "weightedRankingScore":
+			out.WeightedRankingScore = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2845,68 +5342,52 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo18(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo18(out *jwriter.Writer, in KeysResults) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(out *jwriter.Writer, in HitFederation) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"results\":"
+		const prefix string = ",\"indexUid\":"
 		out.RawString(prefix[1:])
-		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v74, v75 := range in.Results {
-				if v74 > 0 {
-					out.RawByte(',')
-				}
-				(v75).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"offset\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Offset))
+		out.String(string(in.IndexUID))
 	}
 	{
-		const prefix string = ",\"limit\":"
+		const prefix string = ",\"queriesPosition\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Limit))
+		out.Int64(int64(in.QueriesPosition))
 	}
 	{
-		const prefix string = ",\"total\":"
+		const prefix string = ",\"weightedRankingScore\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Total))
+		out.Float64(float64(in.WeightedRankingScore))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v KeysResults) MarshalJSON() ([]byte, error) {
+func (v HitFederation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo18(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v KeysResults) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo18(w, v)
+func (v HitFederation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *KeysResults) UnmarshalJSON(data []byte) error {
+func (v *HitFederation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo18(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *KeysResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo18(l, v)
+func (v *HitFederation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo19(in *jlexer.Lexer, out *KeysQuery) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo36(in *jlexer.Lexer, out *Health) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2925,10 +5406,8 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo19(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "Limit":
-			out.Limit = int64(in.Int64())
-		case "Offset":
-			out.Offset = int64(in.Int64())
+		case "status":
+			out.Status = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2939,47 +5418,42 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo19(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo19(out *jwriter.Writer, in KeysQuery) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo36(out *jwriter.Writer, in Health) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Limit\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Limit))
-	}
-	{
-		const prefix string = ",\"Offset\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Offset))
+		out.String(string(in.Status))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v KeysQuery) MarshalJSON() ([]byte, error) {
+func (v Health) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo19(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo36(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v KeysQuery) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo19(w, v)
+func (v Health) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo36(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *KeysQuery) UnmarshalJSON(data []byte) error {
+func (v *Health) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo19(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo36(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *KeysQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo19(l, v)
+func (v *Health) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo36(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo20(in *jlexer.Lexer, out *KeyUpdate) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo37(in *jlexer.Lexer, out *FederationOptions) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2998,10 +5472,8 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo20(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
+		case "weight":
+			out.Weight = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3012,141 +5484,63 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo20(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo20(out *jwriter.Writer, in KeyUpdate) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo37(out *jwriter.Writer, in FederationOptions) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Name != "" {
-		const prefix string = ",\"name\":"
+	if in.Weight != 0 {
+		const prefix string = ",\"weight\":"
 		first = false
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	if in.Description != "" {
-		const prefix string = ",\"description\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Description))
+		out.Float64(float64(in.Weight))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v KeyUpdate) MarshalJSON() ([]byte, error) {
+func (v FederationOptions) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo20(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo37(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v KeyUpdate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo20(w, v)
+func (v FederationOptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo37(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *KeyUpdate) UnmarshalJSON(data []byte) error {
+func (v *FederationOptions) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo20(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo37(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *KeyUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo20(l, v)
+func (v *FederationOptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo37(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo21(in *jlexer.Lexer, out *KeyParsed) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo38(in *jlexer.Lexer, out *Faceting) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "uid":
-			out.UID = string(in.String())
-		case "actions":
-			if in.IsNull() {
-				in.Skip()
-				out.Actions = nil
-			} else {
-				in.Delim('[')
-				if out.Actions == nil {
-					if !in.IsDelim(']') {
-						out.Actions = make([]string, 0, 4)
-					} else {
-						out.Actions = []string{}
-					}
-				} else {
-					out.Actions = (out.Actions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v76 string
-					v76 = string(in.String())
-					out.Actions = append(out.Actions, v76)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "indexes":
-			if in.IsNull() {
-				in.Skip()
-				out.Indexes = nil
-			} else {
-				in.Delim('[')
-				if out.Indexes == nil {
-					if !in.IsDelim(']') {
-						out.Indexes = make([]string, 0, 4)
-					} else {
-						out.Indexes = []string{}
-					}
-				} else {
-					out.Indexes = (out.Indexes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v77 string
-					v77 = string(in.String())
-					out.Indexes = append(out.Indexes, v77)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "expiresAt":
-			if in.IsNull() {
-				in.Skip()
-				out.ExpiresAt = nil
-			} else {
-				if out.ExpiresAt == nil {
-					out.ExpiresAt = new(string)
-				}
-				*out.ExpiresAt = string(in.String())
-			}
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "maxValuesPerFacet":
+			out.MaxValuesPerFacet = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3157,99 +5551,115 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo21(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo21(out *jwriter.Writer, in KeyParsed) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo38(out *jwriter.Writer, in Faceting) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"maxValuesPerFacet\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	if in.UID != "" {
-		const prefix string = ",\"uid\":"
-		out.RawString(prefix)
-		out.String(string(in.UID))
+		out.Int64(int64(in.MaxValuesPerFacet))
 	}
-	if len(in.Actions) != 0 {
-		const prefix string = ",\"actions\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v78, v79 := range in.Actions {
-				if v78 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v79))
-			}
-			out.RawByte(']')
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Faceting) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Faceting) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Faceting) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Faceting) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo38(l, v)
+}
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo39(in *jlexer.Lexer, out *FacetStat) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
 		}
+		in.Skip()
+		return
 	}
-	if len(in.Indexes) != 0 {
-		const prefix string = ",\"indexes\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v80, v81 := range in.Indexes {
-				if v80 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v81))
-			}
-			out.RawByte(']')
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "min":
+			out.Min = float64(in.Float64())
+		case "max":
+			out.Max = float64(in.Float64())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
 	}
-	if true {
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
-	if true {
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+}
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo39(out *jwriter.Writer, in FacetStat) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"min\":"
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Min))
 	}
 	{
-		const prefix string = ",\"expiresAt\":"
+		const prefix string = ",\"max\":"
 		out.RawString(prefix)
-		if in.ExpiresAt == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.ExpiresAt))
-		}
+		out.Float64(float64(in.Max))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v KeyParsed) MarshalJSON() ([]byte, error) {
+func (v FacetStat) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo21(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo39(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v KeyParsed) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo21(w, v)
+func (v FacetStat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo39(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *KeyParsed) UnmarshalJSON(data []byte) error {
+func (v *FacetStat) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo21(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo39(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *KeyParsed) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo21(l, v)
+func (v *FacetStat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo39(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo22(in *jlexer.Lexer, out *Key) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo40(in *jlexer.Lexer, out *FacetSearchResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3268,72 +5678,33 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo22(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "key":
-			out.Key = string(in.String())
-		case "uid":
-			out.UID = string(in.String())
-		case "actions":
-			if in.IsNull() {
-				in.Skip()
-				out.Actions = nil
-			} else {
-				in.Delim('[')
-				if out.Actions == nil {
-					if !in.IsDelim(']') {
-						out.Actions = make([]string, 0, 4)
-					} else {
-						out.Actions = []string{}
-					}
-				} else {
-					out.Actions = (out.Actions)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v82 string
-					v82 = string(in.String())
-					out.Actions = append(out.Actions, v82)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "indexes":
+		case "facetHits":
 			if in.IsNull() {
 				in.Skip()
-				out.Indexes = nil
+				out.FacetHits = nil
 			} else {
 				in.Delim('[')
-				if out.Indexes == nil {
+				if out.FacetHits == nil {
 					if !in.IsDelim(']') {
-						out.Indexes = make([]string, 0, 4)
+						out.FacetHits = make([]FacetHit, 0, 2)
 					} else {
-						out.Indexes = []string{}
+						out.FacetHits = []FacetHit{}
 					}
 				} else {
-					out.Indexes = (out.Indexes)[:0]
+					out.FacetHits = (out.FacetHits)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v83 string
-					v83 = string(in.String())
-					out.Indexes = append(out.Indexes, v83)
+					var v135 FacetHit
+					(v135).UnmarshalEasyJSON(in)
+					out.FacetHits = append(out.FacetHits, v135)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "expiresAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
-			}
+		case "facetQuery":
+			out.FacetQuery = string(in.String())
+		case "processingTimeMs":
+			out.ProcessingTimeMs = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3344,100 +5715,63 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo22(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo22(out *jwriter.Writer, in Key) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo40(out *jwriter.Writer, in FacetSearchResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"facetHits\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	if in.Key != "" {
-		const prefix string = ",\"key\":"
-		out.RawString(prefix)
-		out.String(string(in.Key))
-	}
-	if in.UID != "" {
-		const prefix string = ",\"uid\":"
-		out.RawString(prefix)
-		out.String(string(in.UID))
-	}
-	if len(in.Actions) != 0 {
-		const prefix string = ",\"actions\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v84, v85 := range in.Actions {
-				if v84 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v85))
-			}
-			out.RawByte(']')
-		}
-	}
-	if len(in.Indexes) != 0 {
-		const prefix string = ",\"indexes\":"
-		out.RawString(prefix)
-		{
+		if in.FacetHits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
 			out.RawByte('[')
-			for v86, v87 := range in.Indexes {
-				if v86 > 0 {
+			for v136, v137 := range in.FacetHits {
+				if v136 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v87))
+				(v137).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
-	if true {
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	if true {
-		const prefix string = ",\"updatedAt\":"
+	{
+		const prefix string = ",\"facetQuery\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.String(string(in.FacetQuery))
 	}
 	{
-		const prefix string = ",\"expiresAt\":"
+		const prefix string = ",\"processingTimeMs\":"
 		out.RawString(prefix)
-		out.Raw((in.ExpiresAt).MarshalJSON())
+		out.Int64(int64(in.ProcessingTimeMs))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Key) MarshalJSON() ([]byte, error) {
+func (v FacetSearchResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo22(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Key) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo22(w, v)
+func (v FacetSearchResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Key) UnmarshalJSON(data []byte) error {
+func (v *FacetSearchResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo22(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Key) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo22(l, v)
+func (v *FacetSearchResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo40(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo23(in *jlexer.Lexer, out *IndexesResults) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo41(in *jlexer.Lexer, out *FacetSearchRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3456,35 +5790,45 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo23(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "results":
+		case "facetName":
+			out.FacetName = string(in.String())
+		case "facetQuery":
+			out.FacetQuery = string(in.String())
+		case "q":
+			out.Query = string(in.String())
+		case "filter":
+			if m, ok := out.Filter.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Filter.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Filter = in.Interface()
+			}
+		case "matchingStrategy":
+			out.MatchingStrategy = string(in.String())
+		case "attributesToSearchOn":
 			if in.IsNull() {
 				in.Skip()
-				out.Results = nil
+				out.AttributesToSearchOn = nil
 			} else {
 				in.Delim('[')
-				if out.Results == nil {
+				if out.AttributesToSearchOn == nil {
 					if !in.IsDelim(']') {
-						out.Results = make([]Index, 0, 0)
+						out.AttributesToSearchOn = make([]string, 0, 4)
 					} else {
-						out.Results = []Index{}
+						out.AttributesToSearchOn = []string{}
 					}
 				} else {
-					out.Results = (out.Results)[:0]
+					out.AttributesToSearchOn = (out.AttributesToSearchOn)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v88 Index
-					(v88).UnmarshalEasyJSON(in)
-					out.Results = append(out.Results, v88)
+					var v138 string
+					v138 = string(in.String())
+					out.AttributesToSearchOn = append(out.AttributesToSearchOn, v138)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "offset":
-			out.Offset = int64(in.Int64())
-		case "limit":
-			out.Limit = int64(in.Int64())
-		case "total":
-			out.Total = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3495,68 +5839,82 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo23(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo23(out *jwriter.Writer, in IndexesResults) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo41(out *jwriter.Writer, in FacetSearchRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"results\":"
+		const prefix string = ",\"facetName\":"
 		out.RawString(prefix[1:])
-		if in.Results == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		out.String(string(in.FacetName))
+	}
+	if in.FacetQuery != "" {
+		const prefix string = ",\"facetQuery\":"
+		out.RawString(prefix)
+		out.String(string(in.FacetQuery))
+	}
+	if in.Query != "" {
+		const prefix string = ",\"q\":"
+		out.RawString(prefix)
+		out.String(string(in.Query))
+	}
+	if in.Filter != nil {
+		const prefix string = ",\"filter\":"
+		out.RawString(prefix)
+		if m, ok := in.Filter.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Filter.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
 		} else {
+			out.Raw(json.Marshal(in.Filter))
+		}
+	}
+	if in.MatchingStrategy != "" {
+		const prefix string = ",\"matchingStrategy\":"
+		out.RawString(prefix)
+		out.String(string(in.MatchingStrategy))
+	}
+	if len(in.AttributesToSearchOn) != 0 {
+		const prefix string = ",\"attributesToSearchOn\":"
+		out.RawString(prefix)
+		{
 			out.RawByte('[')
-			for v89, v90 := range in.Results {
-				if v89 > 0 {
+			for v139, v140 := range in.AttributesToSearchOn {
+				if v139 > 0 {
 					out.RawByte(',')
 				}
-				(v90).MarshalEasyJSON(out)
+				out.String(string(v140))
 			}
 			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"offset\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Offset))
-	}
-	{
-		const prefix string = ",\"limit\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Limit))
-	}
-	{
-		const prefix string = ",\"total\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Total))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v IndexesResults) MarshalJSON() ([]byte, error) {
+func (v FacetSearchRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo23(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo41(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v IndexesResults) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo23(w, v)
+func (v FacetSearchRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo41(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *IndexesResults) UnmarshalJSON(data []byte) error {
+func (v *FacetSearchRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo23(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo41(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *IndexesResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo23(l, v)
+func (v *FacetSearchRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo41(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo24(in *jlexer.Lexer, out *IndexesQuery) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo42(in *jlexer.Lexer, out *FacetHit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3575,10 +5933,10 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo24(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "Limit":
-			out.Limit = int64(in.Int64())
-		case "Offset":
-			out.Offset = int64(in.Int64())
+		case "value":
+			out.Value = string(in.String())
+		case "count":
+			out.Count = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3589,47 +5947,47 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo24(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo24(out *jwriter.Writer, in IndexesQuery) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo42(out *jwriter.Writer, in FacetHit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Limit\":"
+		const prefix string = ",\"value\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Limit))
+		out.String(string(in.Value))
 	}
 	{
-		const prefix string = ",\"Offset\":"
+		const prefix string = ",\"count\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Offset))
+		out.Int64(int64(in.Count))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v IndexesQuery) MarshalJSON() ([]byte, error) {
+func (v FacetHit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo24(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo42(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v IndexesQuery) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo24(w, v)
+func (v FacetHit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo42(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *IndexesQuery) UnmarshalJSON(data []byte) error {
+func (v *FacetHit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo24(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo42(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *IndexesQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo24(l, v)
+func (v *FacetHit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo42(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo25(in *jlexer.Lexer, out *Index) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo43(in *jlexer.Lexer, out *EmbedderVectors) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3648,18 +6006,52 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo25(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "uid":
-			out.UID = string(in.String())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+		case "embeddings":
+			if in.IsNull() {
+				in.Skip()
+				out.Embeddings = nil
+			} else {
+				in.Delim('[')
+				if out.Embeddings == nil {
+					if !in.IsDelim(']') {
+						out.Embeddings = make([][]float32, 0, 2)
+					} else {
+						out.Embeddings = [][]float32{}
+					}
+				} else {
+					out.Embeddings = (out.Embeddings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v141 []float32
+					if in.IsNull() {
+						in.Skip()
+						v141 = nil
+					} else {
+						in.Delim('[')
+						if v141 == nil {
+							if !in.IsDelim(']') {
+								v141 = make([]float32, 0, 16)
+							} else {
+								v141 = []float32{}
+							}
+						} else {
+							v141 = (v141)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v142 float32
+							v142 = float32(in.Float32())
+							v141 = append(v141, v142)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					out.Embeddings = append(out.Embeddings, v141)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "primaryKey":
-			out.PrimaryKey = string(in.String())
+		case "regenerate":
+			out.Regenerate = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3670,57 +6062,69 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo25(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo25(out *jwriter.Writer, in Index) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo43(out *jwriter.Writer, in EmbedderVectors) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"uid\":"
+		const prefix string = ",\"embeddings\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.UID))
-	}
-	{
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		if in.Embeddings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v143, v144 := range in.Embeddings {
+				if v143 > 0 {
+					out.RawByte(',')
+				}
+				if v144 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v145, v146 := range v144 {
+						if v145 > 0 {
+							out.RawByte(',')
+						}
+						out.Float32(float32(v146))
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	if in.PrimaryKey != "" {
-		const prefix string = ",\"primaryKey\":"
+		const prefix string = ",\"regenerate\":"
 		out.RawString(prefix)
-		out.String(string(in.PrimaryKey))
+		out.Bool(bool(in.Regenerate))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Index) MarshalJSON() ([]byte, error) {
+func (v EmbedderVectors) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo25(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo43(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Index) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo25(w, v)
+func (v EmbedderVectors) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo43(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Index) UnmarshalJSON(data []byte) error {
+func (v *EmbedderVectors) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo25(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo43(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Index) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo25(l, v)
+func (v *EmbedderVectors) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo43(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo26(in *jlexer.Lexer, out *Health) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo44(in *jlexer.Lexer, out *EmbedderDistribution) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3739,8 +6143,10 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo26(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = string(in.String())
+		case "mean":
+			out.Mean = float64(in.Float64())
+		case "sigma":
+			out.Sigma = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3751,42 +6157,47 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo26(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo26(out *jwriter.Writer, in Health) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo44(out *jwriter.Writer, in EmbedderDistribution) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"mean\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Status))
+		out.Float64(float64(in.Mean))
+	}
+	{
+		const prefix string = ",\"sigma\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Sigma))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Health) MarshalJSON() ([]byte, error) {
+func (v EmbedderDistribution) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo26(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo44(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Health) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo26(w, v)
+func (v EmbedderDistribution) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo44(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Health) UnmarshalJSON(data []byte) error {
+func (v *EmbedderDistribution) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo26(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo44(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Health) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo26(l, v)
+func (v *EmbedderDistribution) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo44(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo27(in *jlexer.Lexer, out *Faceting) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo45(in *jlexer.Lexer, out *Embedder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3805,8 +6216,106 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo27(in *jlexer.Lexer,
 			continue
 		}
 		switch key {
-		case "maxValuesPerFacet":
-			out.MaxValuesPerFacet = int64(in.Int64())
+		case "source":
+			out.Source = EmbedderSource(in.String())
+		case "model":
+			out.Model = string(in.String())
+		case "revision":
+			out.Revision = string(in.String())
+		case "apiKey":
+			out.APIKey = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "documentTemplate":
+			out.DocumentTemplate = string(in.String())
+		case "documentTemplateMaxBytes":
+			out.DocumentTemplateMaxBytes = int64(in.Int64())
+		case "dimensions":
+			out.Dimensions = int64(in.Int64())
+		case "distribution":
+			if in.IsNull() {
+				in.Skip()
+				out.Distribution = nil
+			} else {
+				if out.Distribution == nil {
+					out.Distribution = new(EmbedderDistribution)
+				}
+				(*out.Distribution).UnmarshalEasyJSON(in)
+			}
+		case "request":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Request = make(map[string]interface{})
+				} else {
+					out.Request = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v147 interface{}
+					if m, ok := v147.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v147.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v147 = in.Interface()
+					}
+					(out.Request)[key] = v147
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "response":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Response = make(map[string]interface{})
+				} else {
+					out.Response = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v148 interface{}
+					if m, ok := v148.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v148.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v148 = in.Interface()
+					}
+					(out.Response)[key] = v148
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "headers":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Headers = make(map[string]string)
+				} else {
+					out.Headers = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v149 string
+					v149 = string(in.String())
+					(out.Headers)[key] = v149
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "binaryQuantized":
+			out.BinaryQuantized = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3817,42 +6326,156 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo27(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo27(out *jwriter.Writer, in Faceting) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo45(out *jwriter.Writer, in Embedder) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"maxValuesPerFacet\":"
+		const prefix string = ",\"source\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.MaxValuesPerFacet))
+		out.String(string(in.Source))
+	}
+	if in.Model != "" {
+		const prefix string = ",\"model\":"
+		out.RawString(prefix)
+		out.String(string(in.Model))
+	}
+	if in.Revision != "" {
+		const prefix string = ",\"revision\":"
+		out.RawString(prefix)
+		out.String(string(in.Revision))
+	}
+	if in.APIKey != "" {
+		const prefix string = ",\"apiKey\":"
+		out.RawString(prefix)
+		out.String(string(in.APIKey))
+	}
+	if in.URL != "" {
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	if in.DocumentTemplate != "" {
+		const prefix string = ",\"documentTemplate\":"
+		out.RawString(prefix)
+		out.String(string(in.DocumentTemplate))
+	}
+	if in.DocumentTemplateMaxBytes != 0 {
+		const prefix string = ",\"documentTemplateMaxBytes\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DocumentTemplateMaxBytes))
+	}
+	if in.Dimensions != 0 {
+		const prefix string = ",\"dimensions\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Dimensions))
+	}
+	if in.Distribution != nil {
+		const prefix string = ",\"distribution\":"
+		out.RawString(prefix)
+		(*in.Distribution).MarshalEasyJSON(out)
+	}
+	if len(in.Request) != 0 {
+		const prefix string = ",\"request\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v150First := true
+			for v150Name, v150Value := range in.Request {
+				if v150First {
+					v150First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v150Name))
+				out.RawByte(':')
+				if m, ok := v150Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v150Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v150Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Response) != 0 {
+		const prefix string = ",\"response\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v151First := true
+			for v151Name, v151Value := range in.Response {
+				if v151First {
+					v151First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v151Name))
+				out.RawByte(':')
+				if m, ok := v151Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v151Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v151Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Headers) != 0 {
+		const prefix string = ",\"headers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v152First := true
+			for v152Name, v152Value := range in.Headers {
+				if v152First {
+					v152First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v152Name))
+				out.RawByte(':')
+				out.String(string(v152Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.BinaryQuantized {
+		const prefix string = ",\"binaryQuantized\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.BinaryQuantized))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Faceting) MarshalJSON() ([]byte, error) {
+func (v Embedder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo27(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo45(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Faceting) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo27(w, v)
+func (v Embedder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo45(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Faceting) UnmarshalJSON(data []byte) error {
+func (v *Embedder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo27(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo45(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Faceting) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo27(l, v)
+func (v *Embedder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo45(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo28(in *jlexer.Lexer, out *DocumentsResult) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo46(in *jlexer.Lexer, out *DocumentsResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3887,29 +6510,29 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo28(in *jlexer.Lexer,
 					out.Results = (out.Results)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v91 map[string]interface{}
+					var v153 map[string]interface{}
 					if in.IsNull() {
 						in.Skip()
 					} else {
 						in.Delim('{')
-						v91 = make(map[string]interface{})
+						v153 = make(map[string]interface{})
 						for !in.IsDelim('}') {
 							key := string(in.String())
 							in.WantColon()
-							var v92 interface{}
-							if m, ok := v92.(easyjson.Unmarshaler); ok {
+							var v154 interface{}
+							if m, ok := v154.(easyjson.Unmarshaler); ok {
 								m.UnmarshalEasyJSON(in)
-							} else if m, ok := v92.(json.Unmarshaler); ok {
+							} else if m, ok := v154.(json.Unmarshaler); ok {
 								_ = m.UnmarshalJSON(in.Raw())
 							} else {
-								v92 = in.Interface()
+								v154 = in.Interface()
 							}
-							(v91)[key] = v92
+							(v153)[key] = v154
 							in.WantComma()
 						}
 						in.Delim('}')
 					}
-					out.Results = append(out.Results, v91)
+					out.Results = append(out.Results, v153)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3930,7 +6553,7 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo28(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo28(out *jwriter.Writer, in DocumentsResult) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo46(out *jwriter.Writer, in DocumentsResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3941,29 +6564,29 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo28(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v93, v94 := range in.Results {
-				if v93 > 0 {
+			for v155, v156 := range in.Results {
+				if v155 > 0 {
 					out.RawByte(',')
 				}
-				if v94 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+				if v156 == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
 					out.RawString(`null`)
 				} else {
 					out.RawByte('{')
-					v95First := true
-					for v95Name, v95Value := range v94 {
-						if v95First {
-							v95First = false
+					v157First := true
+					for v157Name, v157Value := range v156 {
+						if v157First {
+							v157First = false
 						} else {
 							out.RawByte(',')
 						}
-						out.String(string(v95Name))
+						out.String(string(v157Name))
 						out.RawByte(':')
-						if m, ok := v95Value.(easyjson.Marshaler); ok {
+						if m, ok := v157Value.(easyjson.Marshaler); ok {
 							m.MarshalEasyJSON(out)
-						} else if m, ok := v95Value.(json.Marshaler); ok {
+						} else if m, ok := v157Value.(json.Marshaler); ok {
 							out.Raw(m.MarshalJSON())
 						} else {
-							out.Raw(json.Marshal(v95Value))
+							out.Raw(json.Marshal(v157Value))
 						}
 					}
 					out.RawByte('}')
@@ -3993,27 +6616,27 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo28(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v DocumentsResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo28(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo46(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DocumentsResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo28(w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo46(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DocumentsResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo28(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo46(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DocumentsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo28(l, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo46(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo29(in *jlexer.Lexer, out *DocumentsQuery) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo47(in *jlexer.Lexer, out *DocumentsQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4052,13 +6675,21 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo29(in *jlexer.Lexer,
 					out.Fields = (out.Fields)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v96 string
-					v96 = string(in.String())
-					out.Fields = append(out.Fields, v96)
+					var v158 string
+					v158 = string(in.String())
+					out.Fields = append(out.Fields, v158)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "filter":
+			if m, ok := out.Filter.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Filter.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Filter = in.Interface()
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4069,7 +6700,7 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo29(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo29(out *jwriter.Writer, in DocumentsQuery) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo47(out *jwriter.Writer, in DocumentsQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4099,42 +6730,58 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo29(out *jwriter.Writ
 		}
 		{
 			out.RawByte('[')
-			for v97, v98 := range in.Fields {
-				if v97 > 0 {
+			for v159, v160 := range in.Fields {
+				if v159 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v98))
+				out.String(string(v160))
 			}
 			out.RawByte(']')
 		}
 	}
+	if in.Filter != nil {
+		const prefix string = ",\"filter\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if m, ok := in.Filter.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Filter.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Filter))
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v DocumentsQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo29(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo47(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DocumentsQuery) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo29(w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo47(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DocumentsQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo29(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo47(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DocumentsQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo29(l, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo47(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo30(in *jlexer.Lexer, out *DocumentQuery) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo48(in *jlexer.Lexer, out *DocumentQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4169,9 +6816,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo30(in *jlexer.Lexer,
 					out.Fields = (out.Fields)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v99 string
-					v99 = string(in.String())
-					out.Fields = append(out.Fields, v99)
+					var v161 string
+					v161 = string(in.String())
+					out.Fields = append(out.Fields, v161)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4186,7 +6833,7 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo30(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo30(out *jwriter.Writer, in DocumentQuery) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo48(out *jwriter.Writer, in DocumentQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4196,11 +6843,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo30(out *jwriter.Writ
 		out.RawString(prefix[1:])
 		{
 			out.RawByte('[')
-			for v100, v101 := range in.Fields {
-				if v100 > 0 {
+			for v162, v163 := range in.Fields {
+				if v162 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v101))
+				out.String(string(v163))
 			}
 			out.RawByte(']')
 		}
@@ -4211,27 +6858,27 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo30(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v DocumentQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo30(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DocumentQuery) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo30(w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DocumentQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo30(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DocumentQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo30(l, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo48(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer, out *Details) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo49(in *jlexer.Lexer, out *Details) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4276,9 +6923,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 					out.RankingRules = (out.RankingRules)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v102 string
-					v102 = string(in.String())
-					out.RankingRules = append(out.RankingRules, v102)
+					var v164 string
+					v164 = string(in.String())
+					out.RankingRules = append(out.RankingRules, v164)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4309,9 +6956,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 					out.SearchableAttributes = (out.SearchableAttributes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v103 string
-					v103 = string(in.String())
-					out.SearchableAttributes = append(out.SearchableAttributes, v103)
+					var v165 string
+					v165 = string(in.String())
+					out.SearchableAttributes = append(out.SearchableAttributes, v165)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4332,9 +6979,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 					out.DisplayedAttributes = (out.DisplayedAttributes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v104 string
-					v104 = string(in.String())
-					out.DisplayedAttributes = append(out.DisplayedAttributes, v104)
+					var v166 string
+					v166 = string(in.String())
+					out.DisplayedAttributes = append(out.DisplayedAttributes, v166)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4355,9 +7002,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 					out.StopWords = (out.StopWords)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v105 string
-					v105 = string(in.String())
-					out.StopWords = append(out.StopWords, v105)
+					var v167 string
+					v167 = string(in.String())
+					out.StopWords = append(out.StopWords, v167)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4375,30 +7022,30 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 				for !in.IsDelim('}') {
 					key := string(in.String())
 					in.WantColon()
-					var v106 []string
+					var v168 []string
 					if in.IsNull() {
 						in.Skip()
-						v106 = nil
+						v168 = nil
 					} else {
 						in.Delim('[')
-						if v106 == nil {
+						if v168 == nil {
 							if !in.IsDelim(']') {
-								v106 = make([]string, 0, 4)
+								v168 = make([]string, 0, 4)
 							} else {
-								v106 = []string{}
+								v168 = []string{}
 							}
 						} else {
-							v106 = (v106)[:0]
+							v168 = (v168)[:0]
 						}
 						for !in.IsDelim(']') {
-							var v107 string
-							v107 = string(in.String())
-							v106 = append(v106, v107)
+							var v169 string
+							v169 = string(in.String())
+							v168 = append(v168, v169)
 							in.WantComma()
 						}
 						in.Delim(']')
 					}
-					(out.Synonyms)[key] = v106
+					(out.Synonyms)[key] = v168
 					in.WantComma()
 				}
 				in.Delim('}')
@@ -4419,9 +7066,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 					out.FilterableAttributes = (out.FilterableAttributes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v108 string
-					v108 = string(in.String())
-					out.FilterableAttributes = append(out.FilterableAttributes, v108)
+					var v170 string
+					v170 = string(in.String())
+					out.FilterableAttributes = append(out.FilterableAttributes, v170)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4442,9 +7089,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 					out.SortableAttributes = (out.SortableAttributes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v109 string
-					v109 = string(in.String())
-					out.SortableAttributes = append(out.SortableAttributes, v109)
+					var v171 string
+					v171 = string(in.String())
+					out.SortableAttributes = append(out.SortableAttributes, v171)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4479,6 +7126,134 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 				}
 				(*out.Faceting).UnmarshalEasyJSON(in)
 			}
+		case "embedders":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Embedders = make(map[string]Embedder)
+				} else {
+					out.Embedders = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v172 Embedder
+					(v172).UnmarshalEasyJSON(in)
+					(out.Embedders)[key] = v172
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "proximityPrecision":
+			out.ProximityPrecision = ProximityPrecision(in.String())
+		case "separatorTokens":
+			if in.IsNull() {
+				in.Skip()
+				out.SeparatorTokens = nil
+			} else {
+				in.Delim('[')
+				if out.SeparatorTokens == nil {
+					if !in.IsDelim(']') {
+						out.SeparatorTokens = make([]string, 0, 4)
+					} else {
+						out.SeparatorTokens = []string{}
+					}
+				} else {
+					out.SeparatorTokens = (out.SeparatorTokens)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v173 string
+					v173 = string(in.String())
+					out.SeparatorTokens = append(out.SeparatorTokens, v173)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nonSeparatorTokens":
+			if in.IsNull() {
+				in.Skip()
+				out.NonSeparatorTokens = nil
+			} else {
+				in.Delim('[')
+				if out.NonSeparatorTokens == nil {
+					if !in.IsDelim(']') {
+						out.NonSeparatorTokens = make([]string, 0, 4)
+					} else {
+						out.NonSeparatorTokens = []string{}
+					}
+				} else {
+					out.NonSeparatorTokens = (out.NonSeparatorTokens)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v174 string
+					v174 = string(in.String())
+					out.NonSeparatorTokens = append(out.NonSeparatorTokens, v174)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "dictionary":
+			if in.IsNull() {
+				in.Skip()
+				out.Dictionary = nil
+			} else {
+				in.Delim('[')
+				if out.Dictionary == nil {
+					if !in.IsDelim(']') {
+						out.Dictionary = make([]string, 0, 4)
+					} else {
+						out.Dictionary = []string{}
+					}
+				} else {
+					out.Dictionary = (out.Dictionary)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v175 string
+					v175 = string(in.String())
+					out.Dictionary = append(out.Dictionary, v175)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "searchCutoffMs":
+			out.SearchCutoffMs = int64(in.Int64())
+		case "localizedAttributes":
+			if in.IsNull() {
+				in.Skip()
+				out.LocalizedAttributes = nil
+			} else {
+				in.Delim('[')
+				if out.LocalizedAttributes == nil {
+					if !in.IsDelim(']') {
+						out.LocalizedAttributes = make([]LocalizedAttributes, 0, 1)
+					} else {
+						out.LocalizedAttributes = []LocalizedAttributes{}
+					}
+				} else {
+					out.LocalizedAttributes = (out.LocalizedAttributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v176 LocalizedAttributes
+					(v176).UnmarshalEasyJSON(in)
+					out.LocalizedAttributes = append(out.LocalizedAttributes, v176)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "facetSearch":
+			if in.IsNull() {
+				in.Skip()
+				out.FacetSearch = nil
+			} else {
+				if out.FacetSearch == nil {
+					out.FacetSearch = new(bool)
+				}
+				*out.FacetSearch = bool(in.Bool())
+			}
+		case "prefixSearch":
+			out.PrefixSearch = PrefixSearch(in.String())
 		case "matchedTasks":
 			out.MatchedTasks = int64(in.Int64())
 		case "canceledTasks":
@@ -4503,9 +7278,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 					out.Swaps = (out.Swaps)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v110 SwapIndexesParams
-					(v110).UnmarshalEasyJSON(in)
-					out.Swaps = append(out.Swaps, v110)
+					var v177 SwapIndexesParams
+					(v177).UnmarshalEasyJSON(in)
+					out.Swaps = append(out.Swaps, v177)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4520,7 +7295,7 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writer, in Details) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo49(out *jwriter.Writer, in Details) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4580,11 +7355,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		{
 			out.RawByte('[')
-			for v111, v112 := range in.RankingRules {
-				if v111 > 0 {
+			for v178, v179 := range in.RankingRules {
+				if v178 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v112))
+				out.String(string(v179))
 			}
 			out.RawByte(']')
 		}
@@ -4609,11 +7384,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		{
 			out.RawByte('[')
-			for v113, v114 := range in.SearchableAttributes {
-				if v113 > 0 {
+			for v180, v181 := range in.SearchableAttributes {
+				if v180 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v114))
+				out.String(string(v181))
 			}
 			out.RawByte(']')
 		}
@@ -4628,11 +7403,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		{
 			out.RawByte('[')
-			for v115, v116 := range in.DisplayedAttributes {
-				if v115 > 0 {
+			for v182, v183 := range in.DisplayedAttributes {
+				if v182 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v116))
+				out.String(string(v183))
 			}
 			out.RawByte(']')
 		}
@@ -4647,11 +7422,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		{
 			out.RawByte('[')
-			for v117, v118 := range in.StopWords {
-				if v117 > 0 {
+			for v184, v185 := range in.StopWords {
+				if v184 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v118))
+				out.String(string(v185))
 			}
 			out.RawByte(']')
 		}
@@ -4666,24 +7441,24 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		{
 			out.RawByte('{')
-			v119First := true
-			for v119Name, v119Value := range in.Synonyms {
-				if v119First {
-					v119First = false
+			v186First := true
+			for v186Name, v186Value := range in.Synonyms {
+				if v186First {
+					v186First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v119Name))
+				out.String(string(v186Name))
 				out.RawByte(':')
-				if v119Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+				if v186Value == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 					out.RawString("null")
 				} else {
 					out.RawByte('[')
-					for v120, v121 := range v119Value {
-						if v120 > 0 {
+					for v187, v188 := range v186Value {
+						if v187 > 0 {
 							out.RawByte(',')
 						}
-						out.String(string(v121))
+						out.String(string(v188))
 					}
 					out.RawByte(']')
 				}
@@ -4701,11 +7476,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		{
 			out.RawByte('[')
-			for v122, v123 := range in.FilterableAttributes {
-				if v122 > 0 {
+			for v189, v190 := range in.FilterableAttributes {
+				if v189 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v123))
+				out.String(string(v190))
 			}
 			out.RawByte(']')
 		}
@@ -4720,11 +7495,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		{
 			out.RawByte('[')
-			for v124, v125 := range in.SortableAttributes {
-				if v124 > 0 {
+			for v191, v192 := range in.SortableAttributes {
+				if v191 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v125))
+				out.String(string(v192))
 			}
 			out.RawByte(']')
 		}
@@ -4759,6 +7534,146 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		(*in.Faceting).MarshalEasyJSON(out)
 	}
+	if len(in.Embedders) != 0 {
+		const prefix string = ",\"embedders\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('{')
+			v193First := true
+			for v193Name, v193Value := range in.Embedders {
+				if v193First {
+					v193First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v193Name))
+				out.RawByte(':')
+				(v193Value).MarshalEasyJSON(out)
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.ProximityPrecision != "" {
+		const prefix string = ",\"proximityPrecision\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ProximityPrecision))
+	}
+	if len(in.SeparatorTokens) != 0 {
+		const prefix string = ",\"separatorTokens\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v194, v195 := range in.SeparatorTokens {
+				if v194 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v195))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.NonSeparatorTokens) != 0 {
+		const prefix string = ",\"nonSeparatorTokens\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v196, v197 := range in.NonSeparatorTokens {
+				if v196 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v197))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Dictionary) != 0 {
+		const prefix string = ",\"dictionary\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v198, v199 := range in.Dictionary {
+				if v198 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v199))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.SearchCutoffMs != 0 {
+		const prefix string = ",\"searchCutoffMs\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int64(int64(in.SearchCutoffMs))
+	}
+	if len(in.LocalizedAttributes) != 0 {
+		const prefix string = ",\"localizedAttributes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v200, v201 := range in.LocalizedAttributes {
+				if v200 > 0 {
+					out.RawByte(',')
+				}
+				(v201).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.FacetSearch != nil {
+		const prefix string = ",\"facetSearch\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(*in.FacetSearch))
+	}
+	if in.PrefixSearch != "" {
+		const prefix string = ",\"prefixSearch\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PrefixSearch))
+	}
 	if in.MatchedTasks != 0 {
 		const prefix string = ",\"matchedTasks\":"
 		if first {
@@ -4809,11 +7724,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 		}
 		{
 			out.RawByte('[')
-			for v126, v127 := range in.Swaps {
-				if v126 > 0 {
+			for v202, v203 := range in.Swaps {
+				if v202 > 0 {
 					out.RawByte(',')
 				}
-				(v127).MarshalEasyJSON(out)
+				(v203).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -4824,27 +7739,27 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v Details) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Details) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo31(w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Details) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Details) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo31(l, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo49(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(in *jlexer.Lexer, out *DeleteTasksQuery) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo50(in *jlexer.Lexer, out *DeleteTasksQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4879,9 +7794,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(in *jlexer.Lexer,
 					out.UIDS = (out.UIDS)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v128 int64
-					v128 = int64(in.Int64())
-					out.UIDS = append(out.UIDS, v128)
+					var v204 int64
+					v204 = int64(in.Int64())
+					out.UIDS = append(out.UIDS, v204)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4902,9 +7817,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(in *jlexer.Lexer,
 					out.IndexUIDS = (out.IndexUIDS)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v129 string
-					v129 = string(in.String())
-					out.IndexUIDS = append(out.IndexUIDS, v129)
+					var v205 string
+					v205 = string(in.String())
+					out.IndexUIDS = append(out.IndexUIDS, v205)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4925,9 +7840,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(in *jlexer.Lexer,
 					out.Statuses = (out.Statuses)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v130 string
-					v130 = string(in.String())
-					out.Statuses = append(out.Statuses, v130)
+					var v206 string
+					v206 = string(in.String())
+					out.Statuses = append(out.Statuses, v206)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4948,9 +7863,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(in *jlexer.Lexer,
 					out.Types = (out.Types)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v131 string
-					v131 = string(in.String())
-					out.Types = append(out.Types, v131)
+					var v207 string
+					v207 = string(in.String())
+					out.Types = append(out.Types, v207)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4971,9 +7886,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(in *jlexer.Lexer,
 					out.CanceledBy = (out.CanceledBy)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v132 int64
-					v132 = int64(in.Int64())
-					out.CanceledBy = append(out.CanceledBy, v132)
+					var v208 int64
+					v208 = int64(in.Int64())
+					out.CanceledBy = append(out.CanceledBy, v208)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5012,7 +7927,7 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(out *jwriter.Writer, in DeleteTasksQuery) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo50(out *jwriter.Writer, in DeleteTasksQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5023,11 +7938,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v133, v134 := range in.UIDS {
-				if v133 > 0 {
+			for v209, v210 := range in.UIDS {
+				if v209 > 0 {
 					out.RawByte(',')
 				}
-				out.Int64(int64(v134))
+				out.Int64(int64(v210))
 			}
 			out.RawByte(']')
 		}
@@ -5039,11 +7954,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v135, v136 := range in.IndexUIDS {
-				if v135 > 0 {
+			for v211, v212 := range in.IndexUIDS {
+				if v211 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v136))
+				out.String(string(v212))
 			}
 			out.RawByte(']')
 		}
@@ -5055,11 +7970,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v137, v138 := range in.Statuses {
-				if v137 > 0 {
+			for v213, v214 := range in.Statuses {
+				if v213 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v138))
+				out.String(string(v214))
 			}
 			out.RawByte(']')
 		}
@@ -5071,11 +7986,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v139, v140 := range in.Types {
-				if v139 > 0 {
+			for v215, v216 := range in.Types {
+				if v215 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v140))
+				out.String(string(v216))
 			}
 			out.RawByte(']')
 		}
@@ -5087,11 +8002,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v141, v142 := range in.CanceledBy {
-				if v141 > 0 {
+			for v217, v218 := range in.CanceledBy {
+				if v217 > 0 {
 					out.RawByte(',')
 				}
-				out.Int64(int64(v142))
+				out.Int64(int64(v218))
 			}
 			out.RawByte(']')
 		}
@@ -5132,27 +8047,27 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v DeleteTasksQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo50(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DeleteTasksQuery) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo32(w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo50(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DeleteTasksQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo50(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DeleteTasksQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo32(l, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo50(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo33(in *jlexer.Lexer, out *CreateIndexRequest) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo51(in *jlexer.Lexer, out *CreateIndexRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5185,7 +8100,7 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo33(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo33(out *jwriter.Writer, in CreateIndexRequest) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo51(out *jwriter.Writer, in CreateIndexRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5211,27 +8126,27 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo33(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v CreateIndexRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo33(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo51(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateIndexRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo33(w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo51(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateIndexRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo33(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo51(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateIndexRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo33(l, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo51(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo34(in *jlexer.Lexer, out *Client) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo52(in *jlexer.Lexer, out *Client) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5260,7 +8175,7 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo34(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo34(out *jwriter.Writer, in Client) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo52(out *jwriter.Writer, in Client) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5270,27 +8185,27 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo34(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v Client) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo34(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo52(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Client) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo34(w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo52(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Client) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo34(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo52(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Client) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo34(l, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo52(l, v)
 }
-func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(in *jlexer.Lexer, out *CancelTasksQuery) {
+func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo53(in *jlexer.Lexer, out *CancelTasksQuery) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5325,9 +8240,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(in *jlexer.Lexer,
 					out.UIDS = (out.UIDS)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v143 int64
-					v143 = int64(in.Int64())
-					out.UIDS = append(out.UIDS, v143)
+					var v219 int64
+					v219 = int64(in.Int64())
+					out.UIDS = append(out.UIDS, v219)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5348,9 +8263,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(in *jlexer.Lexer,
 					out.IndexUIDS = (out.IndexUIDS)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v144 string
-					v144 = string(in.String())
-					out.IndexUIDS = append(out.IndexUIDS, v144)
+					var v220 string
+					v220 = string(in.String())
+					out.IndexUIDS = append(out.IndexUIDS, v220)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5371,9 +8286,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(in *jlexer.Lexer,
 					out.Statuses = (out.Statuses)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v145 string
-					v145 = string(in.String())
-					out.Statuses = append(out.Statuses, v145)
+					var v221 string
+					v221 = string(in.String())
+					out.Statuses = append(out.Statuses, v221)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5394,9 +8309,9 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(in *jlexer.Lexer,
 					out.Types = (out.Types)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v146 string
-					v146 = string(in.String())
-					out.Types = append(out.Types, v146)
+					var v222 string
+					v222 = string(in.String())
+					out.Types = append(out.Types, v222)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5427,7 +8342,7 @@ func easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(in *jlexer.Lexer,
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(out *jwriter.Writer, in CancelTasksQuery) {
+func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo53(out *jwriter.Writer, in CancelTasksQuery) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -5438,11 +8353,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v147, v148 := range in.UIDS {
-				if v147 > 0 {
+			for v223, v224 := range in.UIDS {
+				if v223 > 0 {
 					out.RawByte(',')
 				}
-				out.Int64(int64(v148))
+				out.Int64(int64(v224))
 			}
 			out.RawByte(']')
 		}
@@ -5454,11 +8369,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v149, v150 := range in.IndexUIDS {
-				if v149 > 0 {
+			for v225, v226 := range in.IndexUIDS {
+				if v225 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v150))
+				out.String(string(v226))
 			}
 			out.RawByte(']')
 		}
@@ -5470,11 +8385,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v151, v152 := range in.Statuses {
-				if v151 > 0 {
+			for v227, v228 := range in.Statuses {
+				if v227 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v152))
+				out.String(string(v228))
 			}
 			out.RawByte(']')
 		}
@@ -5486,11 +8401,11 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(out *jwriter.Writ
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v153, v154 := range in.Types {
-				if v153 > 0 {
+			for v229, v230 := range in.Types {
+				if v229 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v154))
+				out.String(string(v230))
 			}
 			out.RawByte(']')
 		}
@@ -5521,23 +8436,23 @@ func easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(out *jwriter.Writ
 // MarshalJSON supports json.Marshaler interface
 func (v CancelTasksQuery) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(&w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo53(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CancelTasksQuery) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo35(w, v)
+	easyjson6601e8cdEncodeGithubComMeilisearchMeilisearchGo53(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CancelTasksQuery) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(&r, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo53(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CancelTasksQuery) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo35(l, v)
+	easyjson6601e8cdDecodeGithubComMeilisearchMeilisearchGo53(l, v)
 }